@@ -9,7 +9,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -37,6 +39,19 @@ type Client struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+
+	// DeletionProtection is the provider-level default for the
+	// deletion_protection attribute of resources. The client itself does not
+	// read it; it travels with the client because that is what resources
+	// receive from the provider.
+	DeletionProtection bool
+
+	// workspaces caches the workspace list used by ResolveWorkspace, and
+	// workspaceMisses the references it could not resolve.
+	workspacesMu    sync.Mutex
+	workspaces      []Workspace
+	workspacesErr   error
+	workspaceMisses map[string]bool
 }
 
 // ClientConfig controls how the Portkey API client connects and retries.
@@ -149,6 +164,60 @@ func IsNotFound(err error) bool {
 		apiErr.StatusCode == http.StatusForbidden
 }
 
+// adminListPageSize is the page size requested from paginated Admin API list
+// endpoints.
+const adminListPageSize = 100
+
+// adminListMaxPages bounds how many pages listAllPages requests, so an
+// endpoint that keeps returning full pages cannot loop forever.
+const adminListMaxPages = 1000
+
+// listAllPages walks a paginated Admin API list endpoint that takes
+// current_page/page_size query parameters. handle decodes one page and
+// reports how many items it held and the API-reported total (0 if unknown).
+// Paging stops at the first short page, once total items have been seen, or
+// when a page repeats the previous one, as it does on endpoints that ignore
+// current_page. Walking more than adminListMaxPages pages is an error.
+func (c *Client) listAllPages(ctx context.Context, path string, handle func(respBody []byte) (count, total int, err error)) error {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	pagePath := func(page int) string {
+		return fmt.Sprintf("%s%scurrent_page=%d&page_size=%d", path, sep, page, adminListPageSize)
+	}
+	return c.walkPages(ctx, path, pagePath, handle)
+}
+
+// walkPages is listAllPages for endpoints with their own paging parameters:
+// pagePath returns the path of the zero-based page, requesting
+// adminListPageSize items.
+func (c *Client) walkPages(ctx context.Context, path string, pagePath func(page int) string, handle func(respBody []byte) (count, total int, err error)) error {
+	seen := 0
+	var previous []byte
+	for page := 0; page < adminListMaxPages; page++ {
+		respBody, err := c.doRequest(ctx, http.MethodGet, pagePath(page), nil)
+		if err != nil {
+			return err
+		}
+		if previous != nil && bytes.Equal(respBody, previous) {
+			return nil
+		}
+		previous = respBody
+
+		count, total, err := handle(respBody)
+		if err != nil {
+			return err
+		}
+		seen += count
+
+		if count < adminListPageSize || (total > 0 && seen >= total) {
+			return nil
+		}
+	}
+	return fmt.Errorf("listing %s: stopped after %d pages of %d items", path, adminListMaxPages, adminListPageSize)
+}
+
 // doRequest performs an HTTP request
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
 	var reqBody io.Reader
@@ -258,6 +327,12 @@ func (c *Client) CreateWorkspace(ctx context.Context, req CreateWorkspaceRequest
 		return nil, fmt.Errorf("error unmarshaling response: %w", err)
 	}
 
+	// The new workspace may be one ResolveWorkspace has already failed to
+	// find.
+	c.workspacesMu.Lock()
+	c.workspaceMisses = nil
+	c.workspacesMu.Unlock()
+
 	return &workspace, nil
 }
 
@@ -276,21 +351,95 @@ func (c *Client) GetWorkspace(ctx context.Context, id string) (*Workspace, error
 	return &workspace, nil
 }
 
-// ListWorkspaces retrieves all workspaces
+// ListWorkspaces retrieves all workspaces, walking every page
 func (c *Client) ListWorkspaces(ctx context.Context) ([]Workspace, error) {
-	respBody, err := c.doRequest(ctx, http.MethodGet, "/admin/workspaces", nil)
+	var all []Workspace
+	err := c.listAllPages(ctx, "/admin/workspaces", func(respBody []byte) (int, int, error) {
+		var response struct {
+			Total int         `json:"total"`
+			Data  []Workspace `json:"data"`
+		}
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return 0, 0, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+		all = append(all, response.Data...)
+		return len(response.Data), response.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var response struct {
-		Data []Workspace `json:"data"`
+	return all, nil
+}
+
+// ErrWorkspaceNotFound is returned by ResolveWorkspace when no workspace has
+// the given ID or slug.
+var ErrWorkspaceNotFound = errors.New("workspace not found")
+
+// ResolveWorkspace returns the workspace whose ID or slug is ref. The
+// workspace list is fetched on first use and cached for the lifetime of the
+// client; a reference missing from the cache triggers one refresh so that
+// workspaces created during the run are found. A reference still missing
+// after that refresh is remembered, so later lookups of it fail without
+// another request until CreateWorkspace adds a workspace. An API error from
+// the list (e.g. a 403 for a workspace-scoped API key) is cached as well, so
+// callers that treat resolution as best-effort only pay for it once.
+//
+// The lock is not held while listing, so concurrent lookups of unknown
+// references may each refresh the list.
+func (c *Client) ResolveWorkspace(ctx context.Context, ref string) (*Workspace, error) {
+	c.workspacesMu.Lock()
+	if c.workspacesErr != nil {
+		err := c.workspacesErr
+		c.workspacesMu.Unlock()
+		return nil, err
 	}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	ws := findWorkspace(c.workspaces, ref)
+	missed := c.workspaceMisses[ref]
+	c.workspacesMu.Unlock()
+	if ws != nil {
+		return ws, nil
+	}
+	if missed {
+		return nil, fmt.Errorf("%w: %q", ErrWorkspaceNotFound, ref)
 	}
 
-	return response.Data, nil
+	workspaces, err := c.ListWorkspaces(ctx)
+
+	c.workspacesMu.Lock()
+	defer c.workspacesMu.Unlock()
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			c.workspacesErr = err
+		}
+		return nil, err
+	}
+	c.workspaces = workspaces
+
+	if ws := findWorkspace(c.workspaces, ref); ws != nil {
+		return ws, nil
+	}
+	if c.workspaceMisses == nil {
+		c.workspaceMisses = make(map[string]bool)
+	}
+	c.workspaceMisses[ref] = true
+	return nil, fmt.Errorf("%w: %q", ErrWorkspaceNotFound, ref)
+}
+
+// findWorkspace returns a copy of the workspace whose ID or slug is ref, or
+// nil.
+func findWorkspace(workspaces []Workspace, ref string) *Workspace {
+	if ref == "" {
+		return nil
+	}
+	for i := range workspaces {
+		if workspaces[i].ID == ref || (workspaces[i].Slug != "" && workspaces[i].Slug == ref) {
+			ws := workspaces[i]
+			return &ws
+		}
+	}
+	return nil
 }
 
 // UpdateWorkspace updates a workspace
@@ -323,18 +472,16 @@ type DeleteWorkspaceRequest struct {
 	ForceDelete bool   `json:"force_delete,omitempty"`
 }
 
-// DeleteWorkspace deletes a workspace and cascades through its dependents.
+// DeleteWorkspace deletes a workspace.
 //
-// Sets force_delete=true so providers/virtual-keys, configs, and
-// workspace API keys are removed atomically with the workspace. Without
-// this, terraform destroy on a workspace with any dependent fails
-// partway through with 409 AB07, leaving the operator to manually
-// clean up every dependent before retrying -- defeating the purpose of
-// declarative state management.
-func (c *Client) DeleteWorkspace(ctx context.Context, id string, name string) error {
+// With force set, force_delete=true is sent so providers/virtual-keys,
+// configs, and workspace API keys are removed atomically with the
+// workspace. Without it, the API refuses with 409 AB07 while any
+// dependent exists.
+func (c *Client) DeleteWorkspace(ctx context.Context, id string, name string, force bool) error {
 	req := DeleteWorkspaceRequest{
 		Name:        name,
-		ForceDelete: true,
+		ForceDelete: force,
 	}
 	_, err := c.doRequest(ctx, http.MethodDelete, "/admin/workspaces/"+id, req)
 	return err
@@ -344,6 +491,8 @@ func (c *Client) DeleteWorkspace(ctx context.Context, id string, name string) er
 type User struct {
 	ID        string    `json:"id"`
 	Email     string    `json:"email"`
+	FirstName string    `json:"first_name,omitempty"`
+	LastName  string    `json:"last_name,omitempty"`
 	Role      string    `json:"role"`
 	Status    string    `json:"status"`
 	CreatedAt time.Time `json:"created_at"`
@@ -393,6 +542,21 @@ type ListUsersResponse struct {
 // codebase use snake_case so they are intentionally not abstracted into a
 // shared helper.
 func (c *Client) ListUsersPaginated(ctx context.Context, opts ListUsersOptions) (*ListUsersResponse, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, listUsersPath(opts), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListUsersResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// listUsersPath returns the GET /admin/users path for opts.
+func listUsersPath(opts ListUsersOptions) string {
 	path := "/admin/users"
 	var params []string
 	if opts.PageSize > 0 {
@@ -410,18 +574,51 @@ func (c *Client) ListUsersPaginated(ctx context.Context, opts ListUsersOptions)
 	if len(params) > 0 {
 		path += "?" + strings.Join(params, "&")
 	}
+	return path
+}
 
-	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+// ListUsers retrieves every user in the organization, walking all pages of
+// GET /admin/users.
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	var all []User
+	pagePath := func(page int) string {
+		return listUsersPath(ListUsersOptions{PageSize: adminListPageSize, CurrentPage: page})
+	}
+	err := c.walkPages(ctx, "/admin/users", pagePath, func(respBody []byte) (int, int, error) {
+		var response ListUsersResponse
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return 0, 0, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+		all = append(all, response.Data...)
+		return len(response.Data), response.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	return all, nil
+}
 
-	var response ListUsersResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+// GetUserByEmail returns the organization user with the given email, matched
+// case-insensitively. It returns a 404 APIError when no such user exists, so
+// callers can use IsNotFound.
+func (c *Client) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	// The server-side filter may match loosely, so the exact address is
+	// checked here.
+	resp, err := c.ListUsersPaginated(ctx, ListUsersOptions{PageSize: adminListPageSize, Email: email})
+	if err != nil {
+		return nil, err
 	}
 
-	return &response, nil
+	for i := range resp.Data {
+		if strings.EqualFold(resp.Data[i].Email, email) {
+			return &resp.Data[i], nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Body:       fmt.Sprintf("no user with email %s found in the organization", email),
+	}
 }
 
 // UpdateUserRequest represents the request to update a user
@@ -491,19 +688,7 @@ type AddWorkspaceMemberRequest struct {
 
 // AddWorkspaceMember adds a member to a workspace
 func (c *Client) AddWorkspaceMember(ctx context.Context, workspaceID string, req AddWorkspaceMemberRequest) (*WorkspaceMember, error) {
-	// The API expects { users: [{ id, role }] } format
-	addReq := addWorkspaceUsersRequest{
-		Users: []workspaceUserItem{
-			{
-				ID:   req.UserID,
-				Role: req.Role,
-			},
-		},
-	}
-
-	path := fmt.Sprintf("/admin/workspaces/%s/users", workspaceID)
-	_, err := c.doRequest(ctx, http.MethodPost, path, addReq)
-	if err != nil {
+	if err := c.AddWorkspaceMembers(ctx, workspaceID, []AddWorkspaceMemberRequest{req}); err != nil {
 		return nil, err
 	}
 
@@ -516,6 +701,28 @@ func (c *Client) AddWorkspaceMember(ctx context.Context, workspaceID string, req
 	return member, nil
 }
 
+// AddWorkspaceMembers adds several members to a workspace in a single request
+func (c *Client) AddWorkspaceMembers(ctx context.Context, workspaceID string, reqs []AddWorkspaceMemberRequest) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	// The API expects { users: [{ id, role }] } format
+	addReq := addWorkspaceUsersRequest{
+		Users: make([]workspaceUserItem, 0, len(reqs)),
+	}
+	for _, req := range reqs {
+		addReq.Users = append(addReq.Users, workspaceUserItem{
+			ID:   req.UserID,
+			Role: req.Role,
+		})
+	}
+
+	path := fmt.Sprintf("/admin/workspaces/%s/users", workspaceID)
+	_, err := c.doRequest(ctx, http.MethodPost, path, addReq)
+	return err
+}
+
 // GetWorkspaceMember retrieves a workspace member
 func (c *Client) GetWorkspaceMember(ctx context.Context, workspaceID, userID string) (*WorkspaceMember, error) {
 	path := fmt.Sprintf("/admin/workspaces/%s/users/%s", workspaceID, userID)
@@ -537,27 +744,31 @@ func (c *Client) GetWorkspaceMember(ctx context.Context, workspaceID, userID str
 	return &member, nil
 }
 
-// ListWorkspaceMembers retrieves all members of a workspace
+// ListWorkspaceMembers retrieves all members of a workspace, walking every page
 func (c *Client) ListWorkspaceMembers(ctx context.Context, workspaceID string) ([]WorkspaceMember, error) {
+	var all []WorkspaceMember
 	path := fmt.Sprintf("/admin/workspaces/%s/users", workspaceID)
-	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	err := c.listAllPages(ctx, path, func(respBody []byte) (int, int, error) {
+		var response struct {
+			Total int               `json:"total"`
+			Data  []WorkspaceMember `json:"data"`
+		}
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return 0, 0, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+		all = append(all, response.Data...)
+		return len(response.Data), response.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var response struct {
-		Data []WorkspaceMember `json:"data"`
-	}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
 	// Normalize all members
-	for i := range response.Data {
-		normalizeWorkspaceMember(&response.Data[i])
+	for i := range all {
+		normalizeWorkspaceMember(&all[i])
 	}
 
-	return response.Data, nil
+	return all, nil
 }
 
 // UpdateWorkspaceMemberRequest represents the request to update a workspace member
@@ -649,21 +860,25 @@ func (c *Client) GetUserInvite(ctx context.Context, id string) (*UserInvite, err
 	return &invite, nil
 }
 
-// ListUserInvites retrieves all user invitations
+// ListUserInvites retrieves all user invitations, walking every page
 func (c *Client) ListUserInvites(ctx context.Context) ([]UserInvite, error) {
-	respBody, err := c.doRequest(ctx, http.MethodGet, "/admin/users/invites", nil)
+	var all []UserInvite
+	err := c.listAllPages(ctx, "/admin/users/invites", func(respBody []byte) (int, int, error) {
+		var response struct {
+			Total int          `json:"total"`
+			Data  []UserInvite `json:"data"`
+		}
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return 0, 0, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+		all = append(all, response.Data...)
+		return len(response.Data), response.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var response struct {
-		Data []UserInvite `json:"data"`
-	}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
-	return response.Data, nil
+	return all, nil
 }
 
 // DeleteUserInvite deletes a user invitation
@@ -1069,6 +1284,7 @@ type CreateProviderRequest struct {
 	ModelConfig   map[string]interface{} `json:"model_config,omitempty"`
 	RateLimits    []RateLimit            `json:"rate_limits,omitempty"`
 	UsageLimits   *UsageLimits           `json:"usage_limits,omitempty"`
+	ExpiresAt     string                 `json:"expires_at,omitempty"`
 }
 
 // CreateProviderResponse represents the response from creating a provider
@@ -1078,14 +1294,20 @@ type CreateProviderResponse struct {
 	Object string `json:"object"`
 }
 
-// UpdateProviderRequest represents the request to update a provider
+// UpdateProviderRequest represents the request to update a provider.
+// ModelConfig, RateLimits, UsageLimits and ExpiresAt use json.RawMessage for
+// the same three-state semantics as UpdateAPIKeyRequest:
+//   - nil:             field omitted from JSON → no change to existing value
+//   - client.JSONNull: sends "field": null    → clears/removes the value
+//   - marshaled JSON:  sends the new value
 type UpdateProviderRequest struct {
-	Name        string                 `json:"name,omitempty"`
-	WorkspaceID string                 `json:"workspace_id"`
-	Note        string                 `json:"note,omitempty"`
-	ModelConfig map[string]interface{} `json:"model_config,omitempty"`
-	RateLimits  []RateLimit            `json:"rate_limits,omitempty"`
-	UsageLimits *UsageLimits           `json:"usage_limits,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	WorkspaceID string          `json:"workspace_id"`
+	Note        string          `json:"note,omitempty"`
+	ModelConfig json.RawMessage `json:"model_config,omitempty"`
+	RateLimits  json.RawMessage `json:"rate_limits,omitempty"`
+	UsageLimits json.RawMessage `json:"usage_limits,omitempty"`
+	ExpiresAt   json.RawMessage `json:"expires_at,omitempty"`
 }
 
 // CreateProvider creates a new provider
@@ -1119,26 +1341,30 @@ func (c *Client) GetProvider(ctx context.Context, id, workspaceID string) (*Prov
 	return &provider, nil
 }
 
-// ListProviders retrieves all providers for a workspace
+// ListProviders retrieves all providers for a workspace, walking every page
 func (c *Client) ListProviders(ctx context.Context, workspaceID string) ([]Provider, error) {
 	path := "/providers"
 	if workspaceID != "" {
 		path = fmt.Sprintf("/providers?workspace_id=%s", workspaceID)
 	}
 
-	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	var all []Provider
+	err := c.listAllPages(ctx, path, func(respBody []byte) (int, int, error) {
+		var response struct {
+			Total int        `json:"total"`
+			Data  []Provider `json:"data"`
+		}
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return 0, 0, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+		all = append(all, response.Data...)
+		return len(response.Data), response.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var response struct {
-		Data []Provider `json:"data"`
-	}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
-	return response.Data, nil
+	return all, nil
 }
 
 // UpdateProvider updates a provider
@@ -1269,46 +1495,57 @@ func (c *Client) GetConfig(ctx context.Context, slug string) (*Config, error) {
 		UpdatedAt:      apiResp.UpdatedAt,
 	}
 
-	// Handle config field which can be a string (JSON) or object
-	switch v := apiResp.Config.(type) {
+	config.Config, config.ConfigRaw = decodeConfigField(apiResp.Config)
+
+	return config, nil
+}
+
+// decodeConfigField normalises a config body that the API returns either as
+// a JSON string or as an object, returning both the parsed map and the raw
+// JSON string.
+func decodeConfigField(value interface{}) (map[string]interface{}, string) {
+	switch v := value.(type) {
 	case string:
-		config.ConfigRaw = v
 		// Parse string to map
 		var configMap map[string]interface{}
 		if err := json.Unmarshal([]byte(v), &configMap); err == nil {
-			config.Config = configMap
+			return configMap, v
 		}
+		return nil, v
 	case map[string]interface{}:
-		config.Config = v
 		// Convert to string
 		if configBytes, err := json.Marshal(v); err == nil {
-			config.ConfigRaw = string(configBytes)
+			return v, string(configBytes)
 		}
+		return v, ""
 	}
-
-	return config, nil
+	return nil, ""
 }
 
-// ListConfigs retrieves all configs
+// ListConfigs retrieves all configs, walking every page
 func (c *Client) ListConfigs(ctx context.Context, workspaceID string) ([]Config, error) {
 	path := "/configs"
 	if workspaceID != "" {
 		path = fmt.Sprintf("/configs?workspace_id=%s", workspaceID)
 	}
 
-	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	var all []Config
+	err := c.listAllPages(ctx, path, func(respBody []byte) (int, int, error) {
+		var response struct {
+			Total int      `json:"total"`
+			Data  []Config `json:"data"`
+		}
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return 0, 0, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+		all = append(all, response.Data...)
+		return len(response.Data), response.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var response struct {
-		Data []Config `json:"data"`
-	}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
-	return response.Data, nil
+	return all, nil
 }
 
 // UpdateConfig updates a config
@@ -1332,6 +1569,85 @@ func (c *Client) DeleteConfig(ctx context.Context, slug string) error {
 	return err
 }
 
+// ConfigVersion represents a single saved version of a Portkey config
+type ConfigVersion struct {
+	VersionID string
+	Config    map[string]interface{} // Parsed config map
+	ConfigRaw string                 // Raw config string
+	Status    string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// configVersionAPIResponse is used for unmarshaling config versions. Depending
+// on the endpoint the version identifier is returned as "version_id" or "id",
+// and the config body as a JSON string or an object.
+type configVersionAPIResponse struct {
+	ID        string      `json:"id"`
+	VersionID string      `json:"version_id"`
+	Config    interface{} `json:"config"`
+	Status    string      `json:"status"`
+	CreatedBy string      `json:"created_by,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// ListConfigVersions retrieves all versions of a config, newest first
+func (c *Client) ListConfigVersions(ctx context.Context, slug string) ([]ConfigVersion, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, "/configs/"+slug+"/versions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data []configVersionAPIResponse `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	versions := make([]ConfigVersion, 0, len(response.Data))
+	for _, v := range response.Data {
+		version := ConfigVersion{
+			VersionID: v.VersionID,
+			Status:    v.Status,
+			CreatedBy: v.CreatedBy,
+			CreatedAt: v.CreatedAt,
+		}
+		if version.VersionID == "" {
+			version.VersionID = v.ID
+		}
+		version.Config, version.ConfigRaw = decodeConfigField(v.Config)
+		versions = append(versions, version)
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].CreatedAt.After(versions[j].CreatedAt)
+	})
+
+	return versions, nil
+}
+
+// GetConfigVersion retrieves a single version of a config by version ID. A
+// version that does not exist is reported as a 404 APIError so callers can
+// use IsNotFound.
+func (c *Client) GetConfigVersion(ctx context.Context, slug, versionID string) (*ConfigVersion, error) {
+	versions, err := c.ListConfigVersions(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range versions {
+		if versions[i].VersionID == versionID {
+			return &versions[i], nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Body:       fmt.Sprintf("version %s not found for config %s", versionID, slug),
+	}
+}
+
 // Prompt represents a Portkey prompt
 type Prompt struct {
 	ID                       string                 `json:"id"`
@@ -1366,6 +1682,10 @@ type CreatePromptRequest struct {
 	VirtualKey         string                 `json:"virtual_key"`
 	VersionDescription string                 `json:"version_description,omitempty"`
 	TemplateMetadata   map[string]interface{} `json:"template_metadata,omitempty"`
+	Functions          []interface{}          `json:"functions,omitempty"`
+	Tools              []interface{}          `json:"tools,omitempty"`
+	ToolChoice         interface{}            `json:"tool_choice,omitempty"`
+	IsRawTemplate      *int                   `json:"is_raw_template,omitempty"`
 }
 
 // CreatePromptResponse represents the response from creating a prompt
@@ -1379,6 +1699,12 @@ type CreatePromptResponse struct {
 // Note: parameters, model, virtual_key, and is_raw_template use non-omitempty
 // tags or pointer types to ensure they're included in version-creating updates.
 // The API requires these fields when creating a new version.
+//
+// TemplateMetadata, Functions, Tools and ToolChoice use json.RawMessage for
+// three-state semantics:
+//   - nil:             field omitted from JSON → no change to existing value
+//   - client.JSONNull: sends "field": null    → clears the value on the new version
+//   - marshaled JSON:  sends the new value
 type UpdatePromptRequest struct {
 	Name               string                 `json:"name,omitempty"`
 	CollectionID       string                 `json:"collection_id,omitempty"`
@@ -1387,8 +1713,11 @@ type UpdatePromptRequest struct {
 	Model              string                 `json:"model,omitempty"`
 	VirtualKey         string                 `json:"virtual_key,omitempty"`
 	VersionDescription string                 `json:"version_description,omitempty"`
-	TemplateMetadata   map[string]interface{} `json:"template_metadata,omitempty"`
+	TemplateMetadata   json.RawMessage        `json:"template_metadata,omitempty"`
 	IsRawTemplate      *int                   `json:"is_raw_template,omitempty"`
+	Functions          json.RawMessage        `json:"functions,omitempty"`
+	Tools              json.RawMessage        `json:"tools,omitempty"`
+	ToolChoice         json.RawMessage        `json:"tool_choice,omitempty"`
 }
 
 // UpdatePromptResponse represents the response from updating a prompt
@@ -1480,8 +1809,11 @@ func (c *Client) UpdatePrompt(ctx context.Context, slugOrID string, req UpdatePr
 
 // PromptVersionListEntry represents a single version in the versions list response.
 type PromptVersionListEntry struct {
-	ID            string `json:"id"` // prompt_version_id
-	PromptVersion int    `json:"prompt_version"`
+	ID                       string    `json:"id"` // prompt_version_id
+	PromptVersion            int       `json:"prompt_version"`
+	PromptVersionStatus      string    `json:"prompt_version_status,omitempty"`
+	PromptVersionDescription string    `json:"prompt_version_description,omitempty"`
+	CreatedAt                time.Time `json:"created_at"`
 }
 
 // ListPromptVersions lists all versions of a prompt, sorted newest-first.
@@ -1634,8 +1966,11 @@ func (c *Client) UpdatePromptPartial(ctx context.Context, slugOrID string, req U
 
 // PromptPartialVersionListEntry represents a single version in the versions list response.
 type PromptPartialVersionListEntry struct {
-	PromptPartialVersionID string `json:"prompt_partial_version_id"`
-	Version                int    `json:"version"`
+	PromptPartialVersionID     string    `json:"prompt_partial_version_id"`
+	Version                    int       `json:"version"`
+	PromptPartialVersionStatus string    `json:"prompt_partial_version_status,omitempty"`
+	VersionDescription         string    `json:"version_description,omitempty"`
+	CreatedAt                  time.Time `json:"created_at"`
 }
 
 // ListPromptPartialVersions lists all versions of a prompt partial, sorted newest-first.
@@ -1745,26 +2080,30 @@ func (c *Client) GetGuardrail(ctx context.Context, slugOrID string) (*Guardrail,
 	return &guardrail, nil
 }
 
-// ListGuardrails retrieves all guardrails
+// ListGuardrails retrieves all guardrails, walking every page
 func (c *Client) ListGuardrails(ctx context.Context, workspaceID string) ([]Guardrail, error) {
 	path := "/guardrails"
 	if workspaceID != "" {
 		path = fmt.Sprintf("/guardrails?workspace_id=%s", workspaceID)
 	}
 
-	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	var all []Guardrail
+	err := c.listAllPages(ctx, path, func(respBody []byte) (int, int, error) {
+		var response struct {
+			Total int         `json:"total"`
+			Data  []Guardrail `json:"data"`
+		}
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return 0, 0, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+		all = append(all, response.Data...)
+		return len(response.Data), response.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var response struct {
-		Data []Guardrail `json:"data"`
-	}
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
-	return response.Data, nil
+	return all, nil
 }
 
 // UpdateGuardrail updates a guardrail
@@ -2017,10 +2356,10 @@ func (c *Client) DeleteRateLimitsPolicy(ctx context.Context, id string) error {
 
 // IntegrationWorkspaceUsageLimits represents usage limits for a workspace integration
 type IntegrationWorkspaceUsageLimits struct {
-	Type           string `json:"type,omitempty"`            // "cost" or "tokens"
-	CreditLimit    *int   `json:"credit_limit,omitempty"`    // Credit limit value
-	AlertThreshold *int   `json:"alert_threshold,omitempty"` // Alert threshold percentage
-	PeriodicReset  string `json:"periodic_reset,omitempty"`  // "monthly" or "weekly"
+	Type           string   `json:"type,omitempty"`            // "cost" or "tokens"
+	CreditLimit    *float64 `json:"credit_limit,omitempty"`    // Credit limit value
+	AlertThreshold *float64 `json:"alert_threshold,omitempty"` // Alert threshold in dollars
+	PeriodicReset  string   `json:"periodic_reset,omitempty"`  // "monthly" or "weekly"
 }
 
 // IntegrationWorkspaceRateLimits represents rate limits for a workspace integration
@@ -2740,6 +3079,21 @@ func (c *Client) DeleteSecretReference(ctx context.Context, idOrSlug string) err
 
 // ListSecretReferences retrieves all secret references, optionally filtered.
 func (c *Client) ListSecretReferences(ctx context.Context, opts ListSecretReferencesOptions) (*ListSecretReferencesResponse, error) {
+	respBody, err := c.doRequest(ctx, http.MethodGet, secretReferencesPath(opts), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ListSecretReferencesResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// secretReferencesPath returns the GET /secret-references path for opts.
+func secretReferencesPath(opts ListSecretReferencesOptions) string {
 	path := "/secret-references"
 	params := []string{}
 	if opts.ManagerType != "" {
@@ -2757,18 +3111,28 @@ func (c *Client) ListSecretReferences(ctx context.Context, opts ListSecretRefere
 	if len(params) > 0 {
 		path += "?" + strings.Join(params, "&")
 	}
+	return path
+}
 
-	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+// ListAllSecretReferences retrieves every secret reference matching opts,
+// walking all pages. opts.CurrentPage and opts.PageSize are ignored.
+func (c *Client) ListAllSecretReferences(ctx context.Context, opts ListSecretReferencesOptions) ([]SecretReference, error) {
+	opts.CurrentPage = 0
+	opts.PageSize = 0
+
+	var all []SecretReference
+	err := c.listAllPages(ctx, secretReferencesPath(opts), func(respBody []byte) (int, int, error) {
+		var response ListSecretReferencesResponse
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return 0, 0, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+		all = append(all, response.Data...)
+		return len(response.Data), response.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	var response ListSecretReferencesResponse
-	if err := json.Unmarshal(respBody, &response); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
-
-	return &response, nil
+	return all, nil
 }
 
 // ScimWorkspaceMapping represents a SCIM-group → workspace + role binding
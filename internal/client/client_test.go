@@ -269,3 +269,96 @@ func TestIsNotFound(t *testing.T) {
 		})
 	}
 }
+
+// TestResolveWorkspace_CachesAndRefreshes resolves IDs and slugs from one
+// cached list, and refreshes the cache only for unknown references.
+func TestResolveWorkspace_CachesAndRefreshes(t *testing.T) {
+	srv, count := newSequencedServer(t,
+		response{http.StatusOK, `{"total":1,"data":[{"id":"ws-uuid-1","slug":"ws-prod","name":"Production"}]}`},
+		response{http.StatusOK, `{"total":2,"data":[{"id":"ws-uuid-1","slug":"ws-prod","name":"Production"},{"id":"ws-uuid-2","slug":"ws-dev","name":"Development"}]}`},
+	)
+	c := newTestClient(t, srv.URL)
+	ctx := context.Background()
+
+	for _, ref := range []string{"ws-prod", "ws-uuid-1"} {
+		ws, err := c.ResolveWorkspace(ctx, ref)
+		if err != nil {
+			t.Fatalf("ResolveWorkspace(%q): unexpected error: %v", ref, err)
+		}
+		if ws.ID != "ws-uuid-1" || ws.Slug != "ws-prod" {
+			t.Errorf("ResolveWorkspace(%q) = %+v", ref, ws)
+		}
+	}
+	if got := atomic.LoadInt64(count); got != 1 {
+		t.Fatalf("expected 1 list request for cached lookups, got %d", got)
+	}
+
+	ws, err := c.ResolveWorkspace(ctx, "ws-dev")
+	if err != nil {
+		t.Fatalf("ResolveWorkspace(ws-dev): unexpected error: %v", err)
+	}
+	if ws.ID != "ws-uuid-2" {
+		t.Errorf("expected refreshed lookup to find ws-uuid-2, got %+v", ws)
+	}
+
+	_, err = c.ResolveWorkspace(ctx, "ws-missing")
+	if !errors.Is(err, ErrWorkspaceNotFound) {
+		t.Errorf("expected ErrWorkspaceNotFound, got %v", err)
+	}
+	if got := atomic.LoadInt64(count); got != 3 {
+		t.Errorf("expected one refresh per unknown reference (3 requests), got %d", got)
+	}
+
+	_, err = c.ResolveWorkspace(ctx, "ws-missing")
+	if !errors.Is(err, ErrWorkspaceNotFound) {
+		t.Errorf("expected ErrWorkspaceNotFound, got %v", err)
+	}
+	if got := atomic.LoadInt64(count); got != 3 {
+		t.Errorf("expected a repeated miss to be served from the cache (3 requests), got %d", got)
+	}
+}
+
+// TestResolveWorkspace_CreateClearsMisses looks a missed reference up again
+// after CreateWorkspace, since the new workspace may be the one it names.
+func TestResolveWorkspace_CreateClearsMisses(t *testing.T) {
+	srv, count := newSequencedServer(t,
+		response{http.StatusOK, `{"total":0,"data":[]}`},
+		response{http.StatusOK, `{"id":"ws-uuid-2","slug":"ws-dev","name":"Development"}`},
+		response{http.StatusOK, `{"total":1,"data":[{"id":"ws-uuid-2","slug":"ws-dev","name":"Development"}]}`},
+	)
+	c := newTestClient(t, srv.URL)
+	ctx := context.Background()
+
+	if _, err := c.ResolveWorkspace(ctx, "ws-dev"); !errors.Is(err, ErrWorkspaceNotFound) {
+		t.Fatalf("expected ErrWorkspaceNotFound, got %v", err)
+	}
+	if _, err := c.CreateWorkspace(ctx, CreateWorkspaceRequest{Name: "Development"}); err != nil {
+		t.Fatalf("CreateWorkspace: unexpected error: %v", err)
+	}
+	ws, err := c.ResolveWorkspace(ctx, "ws-dev")
+	if err != nil {
+		t.Fatalf("ResolveWorkspace(ws-dev): unexpected error: %v", err)
+	}
+	if ws.ID != "ws-uuid-2" {
+		t.Errorf("expected ws-uuid-2, got %+v", ws)
+	}
+	if got := atomic.LoadInt64(count); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+// TestResolveWorkspace_CachesAPIError does not list workspaces again after
+// the API refused to, e.g. for workspace-scoped API keys.
+func TestResolveWorkspace_CachesAPIError(t *testing.T) {
+	srv, count := newSequencedServer(t, response{http.StatusForbidden, `{"errorCode":"AB03"}`})
+	c := newTestClient(t, srv.URL)
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.ResolveWorkspace(context.Background(), "ws-prod"); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if got := atomic.LoadInt64(count); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of objects a gateway config refers to.
+const (
+	ConfigReferenceVirtualKey = "virtual key"
+	ConfigReferenceProvider   = "provider"
+	ConfigReferenceGuardrail  = "guardrail"
+)
+
+// ConfigReference is a virtual key, provider or guardrail that a gateway
+// config refers to by slug or ID.
+type ConfigReference struct {
+	Kind string
+	Slug string
+	// Path locates the string holding the reference: the object keys
+	// (string) and list indices (int) leading to it from the config root.
+	Path []interface{}
+}
+
+// Location renders Path the way users write it, such as
+// targets[1].virtual_key.
+func (r ConfigReference) Location() string {
+	var b strings.Builder
+	for _, elem := range r.Path {
+		switch elem := elem.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", elem)
+		default:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			fmt.Fprint(&b, elem)
+		}
+	}
+	return b.String()
+}
+
+// ConfigReferences returns the objects that the decoded gateway config refers
+// to: virtual_key values, "@slug" Model Catalog provider and model values,
+// the slugs in input_guardrails and output_guardrails, and the ids of
+// before_request_hooks and after_request_hooks, at the top level and in every
+// nested target. Inline guardrail definitions refer to nothing.
+func ConfigReferences(config map[string]interface{}) []ConfigReference {
+	var refs []ConfigReference
+	collectConfigReferences(config, nil, &refs)
+	return refs
+}
+
+func collectConfigReferences(object map[string]interface{}, prefix []interface{}, refs *[]ConfigReference) {
+	at := func(elems ...interface{}) []interface{} {
+		return append(append([]interface{}{}, prefix...), elems...)
+	}
+
+	if slug, ok := object["virtual_key"].(string); ok && slug != "" {
+		*refs = append(*refs, ConfigReference{ConfigReferenceVirtualKey, slug, at("virtual_key")})
+	}
+	if slug := CatalogProviderSlug(object["provider"]); slug != "" {
+		*refs = append(*refs, ConfigReference{ConfigReferenceProvider, slug, at("provider")})
+	}
+	if params, ok := object["override_params"].(map[string]interface{}); ok {
+		if slug := CatalogProviderSlug(params["model"]); slug != "" {
+			*refs = append(*refs, ConfigReference{ConfigReferenceProvider, slug, at("override_params", "model")})
+		}
+	}
+
+	for _, name := range []string{"input_guardrails", "output_guardrails"} {
+		guardrails, _ := object[name].([]interface{})
+		for i, guardrail := range guardrails {
+			if slug, ok := guardrail.(string); ok && slug != "" {
+				*refs = append(*refs, ConfigReference{ConfigReferenceGuardrail, slug, at(name, i)})
+			}
+		}
+	}
+	for _, name := range []string{"before_request_hooks", "after_request_hooks"} {
+		hooks, _ := object[name].([]interface{})
+		for i, hook := range hooks {
+			hook, _ := hook.(map[string]interface{})
+			if slug, ok := hook["id"].(string); ok && slug != "" {
+				*refs = append(*refs, ConfigReference{ConfigReferenceGuardrail, slug, at(name, i, "id")})
+			}
+		}
+	}
+
+	targets, _ := object["targets"].([]interface{})
+	for i, target := range targets {
+		if target, ok := target.(map[string]interface{}); ok {
+			collectConfigReferences(target, at("targets", i), refs)
+		}
+	}
+}
+
+// CatalogProviderSlug returns the provider slug of a Model Catalog reference
+// such as "@openai-prod" or "@openai-prod/gpt-4o", or "" for other values.
+func CatalogProviderSlug(value interface{}) string {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "@") {
+		return ""
+	}
+	slug, _, _ := strings.Cut(strings.TrimPrefix(s, "@"), "/")
+	return slug
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestListConfigVersions verifies the versions path and that config bodies
+// returned as either JSON strings or objects are normalised, newest first.
+func TestListConfigVersions(t *testing.T) {
+	var capturedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedPath = r.URL.Path
+		_, _ = w.Write([]byte(`{
+			"data": [
+				{"id":"ver-1","config":"{\"retry\":{\"attempts\":1}}","status":"active","created_at":"2026-01-01T00:00:00Z"},
+				{"version_id":"ver-2","config":{"retry":{"attempts":3}},"status":"active","created_at":"2026-02-01T00:00:00Z"}
+			]
+		}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL+"/v1")
+	got, err := c.ListConfigVersions(context.Background(), "pc-routing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if capturedPath != "/v1/configs/pc-routing/versions" {
+		t.Errorf("unexpected path %q", capturedPath)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 versions, got %d", len(got))
+	}
+	if got[0].VersionID != "ver-2" || got[1].VersionID != "ver-1" {
+		t.Errorf("expected newest first with IDs normalised, got %q, %q", got[0].VersionID, got[1].VersionID)
+	}
+	if got[1].ConfigRaw != `{"retry":{"attempts":1}}` || got[1].Config["retry"] == nil {
+		t.Errorf("string config not parsed: %+v", got[1])
+	}
+	if got[0].ConfigRaw != `{"retry":{"attempts":3}}` {
+		t.Errorf("object config not serialised: %q", got[0].ConfigRaw)
+	}
+}
+
+// TestGetConfigVersion_NotFound verifies that an unknown version ID is
+// reported as a not-found error.
+func TestGetConfigVersion_NotFound(t *testing.T) {
+	srv, _ := newSequencedServer(t, response{status: http.StatusOK, body: `{"data":[{"id":"ver-1","config":{}}]}`})
+
+	c := newTestClient(t, srv.URL)
+	if _, err := c.GetConfigVersion(context.Background(), "pc-routing", "ver-1"); err != nil {
+		t.Fatalf("unexpected error for existing version: %v", err)
+	}
+	_, err := c.GetConfigVersion(context.Background(), "pc-routing", "ver-missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not-found error, got %v", err)
+	}
+}
@@ -0,0 +1,323 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestListWorkspaceMembers_Paginates walks every page of the members endpoint
+// and normalises each member.
+func TestListWorkspaceMembers_Paginates(t *testing.T) {
+	const total = 230
+	var pagesRequested []int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/admin/workspaces/ws-1/users" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("current_page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+		pagesRequested = append(pagesRequested, page)
+
+		start := page * pageSize
+		end := start + pageSize
+		if end > total {
+			end = total
+		}
+		var items []string
+		for i := start; i < end; i++ {
+			items = append(items, fmt.Sprintf(`{"user_id":"user-%d","role":"ws-member"}`, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"total":%d,"data":[%s]}`, total, strings.Join(items, ","))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL+"/v1")
+	got, err := c.ListWorkspaceMembers(context.Background(), "ws-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != total {
+		t.Fatalf("expected %d members across pages, got %d", total, len(got))
+	}
+	if got[adminListPageSize].UserID != fmt.Sprintf("user-%d", adminListPageSize) {
+		t.Errorf("unexpected member at index %d: %+v", adminListPageSize, got[adminListPageSize])
+	}
+	if got[0].ID != "user-0" || got[0].Role != "member" {
+		t.Errorf("member not normalised: %+v", got[0])
+	}
+	if len(pagesRequested) != 3 {
+		t.Errorf("expected 3 page requests, got %v", pagesRequested)
+	}
+}
+
+// TestListUserInvites_StopsAtTotal avoids an extra round-trip when the final
+// page is exactly full and the API reports the total.
+func TestListUserInvites_StopsAtTotal(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var items []string
+		for i := 0; i < adminListPageSize; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"inv-%d","email":"u%d@example.com","status":"pending"}`, i, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"total":%d,"data":[%s]}`, adminListPageSize, strings.Join(items, ","))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL)
+	got, err := c.ListUserInvites(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != adminListPageSize {
+		t.Fatalf("expected %d invites, got %d", adminListPageSize, len(got))
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+}
+
+// TestListWorkspaces_Paginates walks every page of the workspaces endpoint so
+// lookups by name see workspaces beyond the first page.
+func TestListWorkspaces_Paginates(t *testing.T) {
+	const total = 150
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("current_page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+		start := page * pageSize
+		end := start + pageSize
+		if end > total {
+			end = total
+		}
+		var items []string
+		for i := start; i < end; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"ws-%d","name":"Workspace %d"}`, i, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"total":%d,"data":[%s]}`, total, strings.Join(items, ","))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL)
+	got, err := c.ListWorkspaces(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != total {
+		t.Fatalf("expected %d workspaces across pages, got %d", total, len(got))
+	}
+	if got[total-1].Name != fmt.Sprintf("Workspace %d", total-1) {
+		t.Errorf("unexpected last workspace: %+v", got[total-1])
+	}
+}
+
+// TestListWorkspaceObjects_Paginate walks every page of the provider,
+// guardrail and config endpoints, keeping the workspace filter on each request.
+func TestListWorkspaceObjects_Paginate(t *testing.T) {
+	const total = 205
+
+	for _, tc := range []struct {
+		name string
+		path string
+		list func(c *Client) ([]string, error)
+	}{
+		{
+			name: "providers",
+			path: "/providers",
+			list: func(c *Client) ([]string, error) {
+				providers, err := c.ListProviders(context.Background(), "ws-1")
+				var ids []string
+				for _, p := range providers {
+					ids = append(ids, p.ID)
+				}
+				return ids, err
+			},
+		},
+		{
+			name: "guardrails",
+			path: "/guardrails",
+			list: func(c *Client) ([]string, error) {
+				guardrails, err := c.ListGuardrails(context.Background(), "ws-1")
+				var ids []string
+				for _, g := range guardrails {
+					ids = append(ids, g.ID)
+				}
+				return ids, err
+			},
+		},
+		{
+			name: "configs",
+			path: "/configs",
+			list: func(c *Client) ([]string, error) {
+				configs, err := c.ListConfigs(context.Background(), "ws-1")
+				var ids []string
+				for _, cfg := range configs {
+					ids = append(ids, cfg.ID)
+				}
+				return ids, err
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var pages []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				if r.URL.Path != tc.path || q.Get("workspace_id") != "ws-1" {
+					t.Errorf("unexpected request %s", r.URL)
+				}
+				page, _ := strconv.Atoi(q.Get("current_page"))
+				pageSize, _ := strconv.Atoi(q.Get("page_size"))
+				pages = append(pages, strconv.Itoa(page))
+
+				start := page * pageSize
+				end := start + pageSize
+				if end > total {
+					end = total
+				}
+				var items []string
+				for i := start; i < end; i++ {
+					items = append(items, fmt.Sprintf(`{"id":"obj-%d","slug":"slug-%d"}`, i, i))
+				}
+				_, _ = fmt.Fprintf(w, `{"total":%d,"data":[%s]}`, total, strings.Join(items, ","))
+			}))
+			t.Cleanup(srv.Close)
+
+			got, err := tc.list(newTestClient(t, srv.URL))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != total {
+				t.Fatalf("expected %d objects across pages, got %d", total, len(got))
+			}
+			if got[total-1] != fmt.Sprintf("obj-%d", total-1) {
+				t.Errorf("unexpected last object %q", got[total-1])
+			}
+			if strings.Join(pages, ",") != "0,1,2" {
+				t.Errorf("expected pages 0,1,2, got %v", pages)
+			}
+		})
+	}
+}
+
+// TestListAllSecretReferences_KeepsFilters sends the caller's filters on every
+// page request.
+func TestListAllSecretReferences_KeepsFilters(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("search") != "prod" || q.Get("manager_type") != "aws_sm" {
+			t.Errorf("filters not forwarded: %s", r.URL.RawQuery)
+		}
+		page, _ := strconv.Atoi(q.Get("current_page"))
+		pages = append(pages, strconv.Itoa(page))
+
+		n := adminListPageSize
+		if page == 1 {
+			n = 1
+		}
+		var items []string
+		for i := 0; i < n; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"sr-%d-%d","slug":"prod-%d","name":"prod"}`, page, i, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(items, ","))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL)
+	got, err := c.ListAllSecretReferences(context.Background(), ListSecretReferencesOptions{ManagerType: "aws_sm", Search: "prod", CurrentPage: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != adminListPageSize+1 {
+		t.Fatalf("expected %d secret references, got %d", adminListPageSize+1, len(got))
+	}
+	if strings.Join(pages, ",") != "0,1" {
+		t.Errorf("expected pages 0,1, got %v", pages)
+	}
+}
+
+// TestListAllPages_StopsOnRepeatedPage stops when an endpoint ignores
+// current_page and returns the same full page again, keeping one copy.
+func TestListAllPages_StopsOnRepeatedPage(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var items []string
+		for i := 0; i < adminListPageSize; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"ws-%d","name":"Workspace %d"}`, i, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(items, ","))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL)
+	got, err := c.ListWorkspaces(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != adminListPageSize {
+		t.Errorf("expected %d workspaces, got %d", adminListPageSize, len(got))
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+// TestListAllPages_MaxPages gives up on an endpoint that never returns a
+// short page.
+func TestListAllPages_MaxPages(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := r.URL.Query().Get("current_page")
+		var items []string
+		for i := 0; i < adminListPageSize; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"ws-%s-%d"}`, page, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(items, ","))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL)
+	if _, err := c.ListWorkspaces(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != adminListMaxPages {
+		t.Errorf("expected %d requests, got %d", adminListMaxPages, requests)
+	}
+}
+
+// TestListUsers_StopsOnRepeatedPage applies the repeated-page guard to the
+// camelCase-paged users endpoint.
+func TestListUsers_StopsOnRepeatedPage(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("pageSize") != strconv.Itoa(adminListPageSize) {
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+		pages = append(pages, r.URL.Query().Get("currentPage"))
+		var items []string
+		for i := 0; i < adminListPageSize; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"user-%d","email":"user-%d@example.com"}`, i, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(items, ","))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL)
+	got, err := c.ListUsers(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != adminListPageSize {
+		t.Errorf("expected %d users, got %d", adminListPageSize, len(got))
+	}
+	if strings.Join(pages, ",") != ",1" {
+		t.Errorf("expected pages <none>,1, got %q", pages)
+	}
+}
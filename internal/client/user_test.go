@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetUserByEmail_ExactMatch narrows the loosely filtered list down to the
+// user whose email matches exactly, ignoring case.
+func TestGetUserByEmail_ExactMatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("email"); got != "Alice@Example.com" {
+			t.Errorf("expected email filter, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"total":2,"data":[
+			{"id":"user-1","email":"alice@example.com.au","role":"member"},
+			{"id":"user-2","email":"alice@example.com","role":"admin"}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := newTestClient(t, srv.URL)
+	user, err := c.GetUserByEmail(context.Background(), "Alice@Example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.ID != "user-2" {
+		t.Errorf("expected user-2, got %+v", user)
+	}
+}
+
+func TestGetUserByEmail_NotFound(t *testing.T) {
+	srv, _ := newSequencedServer(t,
+		response{status: http.StatusOK, body: `{"total":0,"data":[]}`},
+	)
+
+	c := newTestClient(t, srv.URL)
+	_, err := c.GetUserByEmail(context.Background(), "nobody@example.com")
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not-found error, got %v", err)
+	}
+}
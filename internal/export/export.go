@@ -0,0 +1,562 @@
+// Package export generates Terraform configuration for existing Portkey
+// objects: resource blocks plus the import blocks that adopt them. It backs
+// the `export` subcommand of the provider binary, for Terraform versions
+// without `terraform query`.
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Files maps the names of the generated files to their contents.
+type Files map[string][]byte
+
+// Main runs the export subcommand with the arguments following "export" and
+// returns the process exit code.
+func Main(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	workspace := flags.String("workspace", "", "ID or slug of the workspace to export. Exports the whole organisation when empty.")
+	dir := flags.String("out", ".", "Directory to write the generated .tf files to.")
+	baseURL := flags.String("base-url", os.Getenv("PORTKEY_BASE_URL"), "Portkey API base URL. Defaults to PORTKEY_BASE_URL.")
+	force := flags.Bool("force", false, "Overwrite existing files.")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: terraform-provider-portkey export [flags]\n\n"+
+			"Writes resource and import blocks for existing Portkey objects.\n"+
+			"The Admin API key is read from PORTKEY_API_KEY.\n\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	apiKey := os.Getenv("PORTKEY_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(stderr, "export: PORTKEY_API_KEY must be set")
+		return 1
+	}
+	c, err := client.NewClient(*baseURL, apiKey)
+	if err != nil {
+		fmt.Fprintf(stderr, "export: %v\n", err)
+		return 1
+	}
+
+	files, err := Generate(ctx, c, *workspace)
+	if err != nil {
+		fmt.Fprintf(stderr, "export: %v\n", err)
+		return 1
+	}
+	if err := files.write(*dir, *force); err != nil {
+		fmt.Fprintf(stderr, "export: %v\n", err)
+		return 1
+	}
+	for _, name := range files.names() {
+		fmt.Fprintln(stdout, filepath.Join(*dir, name))
+	}
+	return 0
+}
+
+// names returns the file names in order.
+func (f Files) names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// write writes the files to dir. Existing files are only replaced when force
+// is set, and nothing is written if any of them exists.
+func (f Files) write(dir string, force bool) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if !force {
+		for _, name := range f.names() {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				return fmt.Errorf("%s already exists; use -force to overwrite", filepath.Join(dir, name))
+			}
+		}
+	}
+	for _, name := range f.names() {
+		if err := os.WriteFile(filepath.Join(dir, name), f[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshot holds the objects read from the API.
+type snapshot struct {
+	workspaces   []client.Workspace
+	integrations []client.Integration
+	providers    []client.Provider
+	configs      []client.Config
+	guardrails   []client.Guardrail
+}
+
+// Generate reads the organisation, or only the workspace named by the ID or
+// slug workspaceRef, and renders workspaces, integrations, providers, configs
+// and guardrails. In a workspace export only the integrations owned by that
+// workspace are included; providers keep literal references to the others.
+func Generate(ctx context.Context, c *client.Client, workspaceRef string) (Files, error) {
+	snap, err := read(ctx, c, workspaceRef)
+	if err != nil {
+		return nil, err
+	}
+	return render(snap)
+}
+
+// read fetches the objects to export.
+func read(ctx context.Context, c *client.Client, workspaceRef string) (*snapshot, error) {
+	snap := &snapshot{}
+
+	if workspaceRef != "" {
+		ws, err := c.ResolveWorkspace(ctx, workspaceRef)
+		if err != nil {
+			// Workspace-scoped API keys cannot list workspaces; try the
+			// reference as an ID.
+			if ws, err = c.GetWorkspace(ctx, workspaceRef); err != nil {
+				return nil, fmt.Errorf("reading workspace %s: %w", workspaceRef, err)
+			}
+		}
+		snap.workspaces = []client.Workspace{*ws}
+	} else {
+		workspaces, err := c.ListWorkspaces(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing workspaces: %w", err)
+		}
+		snap.workspaces = workspaces
+	}
+	for i, ws := range snap.workspaces {
+		full, err := c.GetWorkspace(ctx, ws.ID)
+		if err != nil {
+			return nil, fmt.Errorf("reading workspace %s: %w", ws.ID, err)
+		}
+		snap.workspaces[i] = *full
+	}
+
+	integrations, err := c.ListIntegrations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing integrations: %w", err)
+	}
+	for _, i := range integrations {
+		if workspaceRef != "" && i.WorkspaceID != snap.workspaces[0].ID {
+			continue
+		}
+		full, err := c.GetIntegration(ctx, i.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("reading integration %s: %w", i.Slug, err)
+		}
+		snap.integrations = append(snap.integrations, *full)
+	}
+
+	for _, ws := range snap.workspaces {
+		providers, err := c.ListProviders(ctx, ws.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing providers of workspace %s: %w", ws.ID, err)
+		}
+		for _, p := range providers {
+			full, err := c.GetProvider(ctx, p.ID, ws.ID)
+			if err != nil {
+				return nil, fmt.Errorf("reading provider %s: %w", p.ID, err)
+			}
+			if full.WorkspaceID == "" {
+				full.WorkspaceID = ws.ID
+			}
+			snap.providers = append(snap.providers, *full)
+		}
+
+		configs, err := c.ListConfigs(ctx, ws.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing configs of workspace %s: %w", ws.ID, err)
+		}
+		for _, cfg := range configs {
+			full, err := c.GetConfig(ctx, cfg.Slug)
+			if err != nil {
+				return nil, fmt.Errorf("reading config %s: %w", cfg.Slug, err)
+			}
+			if full.WorkspaceID == "" {
+				full.WorkspaceID = ws.ID
+			}
+			snap.configs = append(snap.configs, *full)
+		}
+
+		guardrails, err := c.ListGuardrails(ctx, ws.ID)
+		if err != nil {
+			return nil, fmt.Errorf("listing guardrails of workspace %s: %w", ws.ID, err)
+		}
+		for _, g := range guardrails {
+			full, err := c.GetGuardrail(ctx, g.Slug)
+			if err != nil {
+				return nil, fmt.Errorf("reading guardrail %s: %w", g.Slug, err)
+			}
+			if full.WorkspaceID == "" {
+				full.WorkspaceID = ws.ID
+			}
+			snap.guardrails = append(snap.guardrails, *full)
+		}
+	}
+
+	return snap, nil
+}
+
+// render turns the snapshot into files. Names are assigned and references
+// registered for every object before any block is rendered, so an object can
+// refer to any other regardless of order.
+//
+// workspace_id and integration_id arguments are looked up among workspaces
+// and integrations only. Gateway configs name providers and guardrails, by
+// slug or ID, at the positions client.ConfigReferences finds; only those
+// strings are looked up, among providers and guardrails respectively.
+func render(snap *snapshot) (Files, error) {
+	names := resourceNames{}
+	workspaceRefs, integrationRefs := references{}, references{}
+	providerRefs, guardrailRefs := references{}, references{}
+	register := func(refs references, typ, hint string, values map[string]string) string {
+		name := names.name(typ, hint)
+		for attr, value := range values {
+			if _, taken := refs[value]; value != "" && !taken {
+				refs[value] = typ + "." + name + "." + attr
+			}
+		}
+		return name
+	}
+
+	workspaceNames := make([]string, len(snap.workspaces))
+	for i, ws := range snap.workspaces {
+		workspaceNames[i] = register(workspaceRefs, "portkey_workspace", firstNonEmpty(ws.Slug, ws.Name), map[string]string{"id": ws.ID})
+	}
+	integrationNames := make([]string, len(snap.integrations))
+	for i, in := range snap.integrations {
+		integrationNames[i] = register(integrationRefs, "portkey_integration", in.Slug, map[string]string{"slug": in.Slug})
+	}
+	providerNames := make([]string, len(snap.providers))
+	for i, p := range snap.providers {
+		providerNames[i] = register(providerRefs, "portkey_provider", firstNonEmpty(p.Slug, p.Name), map[string]string{"slug": p.Slug, "id": p.ID})
+	}
+	guardrailNames := make([]string, len(snap.guardrails))
+	for i, g := range snap.guardrails {
+		guardrailNames[i] = register(guardrailRefs, "portkey_guardrail", firstNonEmpty(g.Slug, g.Name), map[string]string{"slug": g.Slug, "id": g.ID})
+	}
+	configNames := make([]string, len(snap.configs))
+	for i, cfg := range snap.configs {
+		configNames[i] = names.name("portkey_config", firstNonEmpty(cfg.Slug, cfg.Name))
+	}
+
+	files := Files{}
+	add := func(name string, f *tfFile) {
+		if f.buf.Len() > 0 {
+			files[name] = f.bytes()
+		}
+	}
+
+	var workspaces tfFile
+	for i, ws := range snap.workspaces {
+		workspaces.resource("portkey_workspace", workspaceNames[i], "", workspaceAttributes(ws), ws.ID)
+	}
+	add("workspaces.tf", &workspaces)
+
+	var integrations tfFile
+	for i, in := range snap.integrations {
+		integrations.resource("portkey_integration", integrationNames[i],
+			"Credentials are not returned by the API; add key, key_wo or secret_mappings to manage them.",
+			integrationAttributes(in, workspaceRefs), in.Slug)
+	}
+	add("integrations.tf", &integrations)
+
+	var providers tfFile
+	for i, p := range snap.providers {
+		attrs, err := providerAttributes(p, workspaceRefs, integrationRefs)
+		if err != nil {
+			return nil, fmt.Errorf("rendering provider %s: %w", p.ID, err)
+		}
+		providers.resource("portkey_provider", providerNames[i], "", attrs, p.WorkspaceID+":"+p.ID)
+	}
+	add("providers.tf", &providers)
+
+	var guardrails tfFile
+	for i, g := range snap.guardrails {
+		attrs, err := guardrailAttributes(g, workspaceRefs)
+		if err != nil {
+			return nil, fmt.Errorf("rendering guardrail %s: %w", g.Slug, err)
+		}
+		guardrails.resource("portkey_guardrail", guardrailNames[i], "", attrs, g.Slug)
+	}
+	add("guardrails.tf", &guardrails)
+
+	var configs tfFile
+	for i, cfg := range snap.configs {
+		attrs, err := configAttributes(cfg, workspaceRefs, integrationRefs, providerRefs, guardrailRefs)
+		if err != nil {
+			return nil, fmt.Errorf("rendering config %s: %w", cfg.Slug, err)
+		}
+		configs.resource("portkey_config", configNames[i], "", attrs, cfg.Slug)
+	}
+	add("configs.tf", &configs)
+
+	return files, nil
+}
+
+func workspaceAttributes(ws client.Workspace) []attribute {
+	name := ws.Name
+	if ws.Icon != "" {
+		name = strings.TrimPrefix(name, ws.Icon+" ")
+	}
+	attrs := []attribute{{"name", quote(name)}}
+	if ws.Icon != "" {
+		attrs = append(attrs, attribute{"icon", quote(ws.Icon)})
+	}
+	if ws.Description != "" {
+		attrs = append(attrs, attribute{"description", quote(ws.Description)})
+	}
+	if ws.Defaults != nil && len(ws.Defaults.Metadata) > 0 {
+		metadata := map[string]interface{}{}
+		for k, v := range ws.Defaults.Metadata {
+			metadata[k] = v
+		}
+		attrs = append(attrs, attribute{"metadata", references{}.value(metadata)})
+	}
+	if len(ws.UsageLimits) > 0 {
+		items := make([]interface{}, len(ws.UsageLimits))
+		for i, l := range ws.UsageLimits {
+			item := map[string]interface{}{"type": l.Type}
+			if l.CreditLimit != nil {
+				item["credit_limit"] = *l.CreditLimit
+			}
+			if l.AlertThreshold != nil {
+				item["alert_threshold"] = *l.AlertThreshold
+			}
+			if l.PeriodicReset != "" {
+				item["periodic_reset"] = l.PeriodicReset
+			}
+			items[i] = item
+		}
+		attrs = append(attrs, attribute{"usage_limits", references{}.value(items)})
+	}
+	if len(ws.RateLimits) > 0 {
+		items := make([]interface{}, len(ws.RateLimits))
+		for i, l := range ws.RateLimits {
+			item := map[string]interface{}{"type": l.Type, "unit": l.Unit}
+			if l.Value != nil {
+				item["value"] = float64(*l.Value)
+			}
+			items[i] = item
+		}
+		attrs = append(attrs, attribute{"rate_limits", references{}.value(items)})
+	}
+	return attrs
+}
+
+func integrationAttributes(in client.Integration, workspaceRefs references) []attribute {
+	attrs := []attribute{
+		{"name", quote(in.Name)},
+		{"slug", quote(in.Slug)},
+		{"ai_provider_id", quote(in.AIProviderID)},
+	}
+	if in.Description != "" {
+		attrs = append(attrs, attribute{"description", quote(in.Description)})
+	}
+	if in.WorkspaceID != "" {
+		attrs = append(attrs, attribute{"workspace_id", workspaceRefs.str(in.WorkspaceID)})
+	}
+	if len(in.SecretMappings) > 0 {
+		items := make([]interface{}, len(in.SecretMappings))
+		for i, m := range in.SecretMappings {
+			item := map[string]interface{}{
+				"target_field":        m.TargetField,
+				"secret_reference_id": m.SecretReferenceID,
+			}
+			if m.SecretKey != nil {
+				item["secret_key"] = *m.SecretKey
+			}
+			items[i] = item
+		}
+		attrs = append(attrs, attribute{"secret_mappings", references{}.value(items)})
+	}
+	return attrs
+}
+
+func providerAttributes(p client.Provider, workspaceRefs, integrationRefs references) ([]attribute, error) {
+	attrs := []attribute{
+		{"name", quote(p.Name)},
+		{"slug", quote(p.Slug)},
+		{"workspace_id", workspaceRefs.str(p.WorkspaceID)},
+		{"integration_id", integrationRefs.str(p.IntegrationID)},
+	}
+	if p.Note != "" {
+		attrs = append(attrs, attribute{"note", quote(p.Note)})
+	}
+	if len(p.ModelConfig) > 0 {
+		v, err := references{}.jsonencode(p.ModelConfig)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, attribute{"model_config", v})
+	}
+	if l := p.UsageLimits; l != nil {
+		item := map[string]interface{}{}
+		if l.Type != "" {
+			item["type"] = l.Type
+		}
+		if l.CreditLimit != nil {
+			item["credit_limit"] = *l.CreditLimit
+		}
+		if l.AlertThreshold != nil {
+			item["alert_threshold"] = *l.AlertThreshold
+		}
+		if l.PeriodicReset != "" {
+			item["periodic_reset"] = l.PeriodicReset
+		}
+		if l.PeriodicResetDays != nil {
+			item["periodic_reset_days"] = float64(*l.PeriodicResetDays)
+		}
+		if len(item) > 0 {
+			attrs = append(attrs, attribute{"usage_limits", references{}.value(item)})
+		}
+	}
+	if len(p.RateLimits) > 0 {
+		items := make([]interface{}, len(p.RateLimits))
+		for i, l := range p.RateLimits {
+			items[i] = map[string]interface{}{"type": l.Type, "unit": l.Unit, "value": float64(l.Value)}
+		}
+		attrs = append(attrs, attribute{"rate_limits", references{}.value(items)})
+	}
+	if p.ExpiresAt != nil {
+		attrs = append(attrs, attribute{"expires_at", quote(p.ExpiresAt.UTC().Format("2006-01-02T15:04:05Z07:00"))})
+	}
+	return attrs, nil
+}
+
+func guardrailAttributes(g client.Guardrail, workspaceRefs references) ([]attribute, error) {
+	checks, err := references{}.jsonencode(g.Checks)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := references{}.jsonencode(g.Actions)
+	if err != nil {
+		return nil, err
+	}
+	return []attribute{
+		{"name", quote(g.Name)},
+		{"workspace_id", workspaceRefs.str(g.WorkspaceID)},
+		{"checks", checks},
+		{"actions", actions},
+	}, nil
+}
+
+func configAttributes(cfg client.Config, workspaceRefs, integrationRefs, providerRefs, guardrailRefs references) ([]attribute, error) {
+	attrs := []attribute{
+		{"name", quote(cfg.Name)},
+		{"workspace_id", workspaceRefs.str(cfg.WorkspaceID)},
+	}
+	switch {
+	case cfg.Config != nil:
+		v, err := configJSON(cfg.Config, integrationRefs, providerRefs, guardrailRefs)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, attribute{"config", v})
+	case cfg.ConfigRaw != "":
+		// Not valid JSON; keep the API's string as is.
+		attrs = append(attrs, attribute{"config", quote(cfg.ConfigRaw)})
+	}
+	if cfg.IsDefault != 0 {
+		attrs = append(attrs, attribute{"is_default", "true"})
+	}
+	return attrs, nil
+}
+
+// configJSON renders a gateway config as a jsonencode() call. The virtual
+// keys, providers and guardrails it refers to become references; every other
+// string is kept as written, even one that happens to equal a slug.
+// "@slug" provider values may also name integrations.
+func configJSON(config map[string]interface{}, integrationRefs, providerRefs, guardrailRefs references) (string, error) {
+	decoded, err := decodeJSON(config)
+	if err != nil {
+		return "", err
+	}
+	root, _ := decoded.(map[string]interface{})
+	for _, ref := range client.ConfigReferences(root) {
+		replaceJSONString(root, ref.Path, func(s string) (expression, bool) {
+			switch ref.Kind {
+			case client.ConfigReferenceVirtualKey:
+				target, ok := providerRefs[s]
+				return expression(target), ok
+			case client.ConfigReferenceGuardrail:
+				target, ok := guardrailRefs[s]
+				return expression(target), ok
+			}
+			target, ok := providerRefs[ref.Slug]
+			if !ok {
+				target, ok = integrationRefs[ref.Slug]
+			}
+			// Keep the model after the slug, e.g. "@${...}/gpt-4o".
+			rest := strings.TrimPrefix(quote(strings.TrimPrefix(s, "@"+ref.Slug)), `"`)
+			return expression(`"@${` + target + `}` + rest), ok
+		})
+	}
+	return "jsonencode(" + references{}.value(root) + ")", nil
+}
+
+// replaceJSONString replaces the string at path in a decoded JSON value with
+// the expression replace returns, if it returns one.
+func replaceJSONString(v interface{}, path []interface{}, replace func(string) (expression, bool)) {
+	if len(path) == 0 {
+		return
+	}
+	for _, elem := range path[:len(path)-1] {
+		switch elem := elem.(type) {
+		case string:
+			m, _ := v.(map[string]interface{})
+			v = m[elem]
+		case int:
+			list, _ := v.([]interface{})
+			if elem >= len(list) {
+				return
+			}
+			v = list[elem]
+		}
+	}
+	switch last := path[len(path)-1].(type) {
+	case string:
+		if m, ok := v.(map[string]interface{}); ok {
+			if s, ok := m[last].(string); ok {
+				if e, ok := replace(s); ok {
+					m[last] = e
+				}
+			}
+		}
+	case int:
+		if list, ok := v.([]interface{}); ok && last < len(list) {
+			if s, ok := list[last].(string); ok {
+				if e, ok := replace(s); ok {
+					list[last] = e
+				}
+			}
+		}
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,156 @@
+package export
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// newExportTestClient returns a client for an organisation with one
+// workspace holding a provider, a config routing to it and a guardrail.
+func newExportTestClient(t *testing.T) *client.Client {
+	t.Helper()
+	routes := map[string]string{
+		"/admin/workspaces":           `{"total":1,"data":[{"id":"ws-uuid-1","slug":"prod","name":"Production"}]}`,
+		"/admin/workspaces/ws-uuid-1": `{"id":"ws-uuid-1","slug":"prod","name":"Production","description":"Live traffic","defaults":{"metadata":{"team":"ml"}}}`,
+		"/integrations":               `{"data":[{"id":"int-1","slug":"openai","name":"OpenAI","ai_provider_id":"openai"}]}`,
+		"/integrations/openai":        `{"id":"int-1","slug":"openai","name":"OpenAI","ai_provider_id":"openai","description":"Org key"}`,
+		"/providers":                  `{"total":1,"data":[{"id":"prov-1","slug":"openai","name":"OpenAI Prod"}]}`,
+		"/providers/prov-1":           `{"id":"prov-1","slug":"openai","name":"OpenAI Prod","integration_id":"openai","workspace_id":"ws-uuid-1","rate_limits":[{"type":"requests","unit":"rpm","value":60}]}`,
+		"/configs":                    `{"data":[{"id":"cfg-1","slug":"routing","name":"Routing"}]}`,
+		"/configs/routing":            `{"id":"cfg-1","slug":"routing","name":"Routing","workspace_id":"ws-uuid-1","config":"{\"strategy\":{\"mode\":\"fallback\"},\"targets\":[{\"provider\":\"@openai\",\"override_params\":{\"model\":\"@openai/gpt-4o\",\"user\":\"pii\"}},{\"virtual_key\":\"prov-1\"}],\"input_guardrails\":[\"pii\"],\"metadata\":{\"owner\":\"openai\"}}"}`,
+		"/guardrails":                 `{"total":1,"data":[{"id":"gr-1","slug":"pii","name":"PII"}]}`,
+		"/guardrails/pii":             `{"id":"gr-1","slug":"pii","name":"PII","workspace_id":"ws-uuid-1","checks":[{"id":"default.regexMatch","parameters":{"rule":"${secret}"}}],"actions":{"deny":true}}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestGenerate(t *testing.T) {
+	files, err := Generate(context.Background(), newExportTestClient(t), "")
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	if got, want := strings.Join(files.names(), ","), "configs.tf,guardrails.tf,integrations.tf,providers.tf,workspaces.tf"; got != want {
+		t.Fatalf("files = %s, want %s", got, want)
+	}
+	for name, content := range files {
+		if _, diags := hclsyntax.ParseConfig(content, name, hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("%s is not valid HCL: %s\n%s", name, diags, content)
+		}
+	}
+
+	for name, wants := range map[string][]string{
+		"workspaces.tf": {
+			`resource "portkey_workspace" "prod" {`,
+			`description = "Live traffic"`,
+			`team = "ml"`,
+			`to = portkey_workspace.prod`,
+			`id = "ws-uuid-1"`,
+		},
+		"integrations.tf": {
+			`resource "portkey_integration" "openai" {`,
+			`ai_provider_id = "openai"`,
+			`id = "openai"`,
+		},
+		"providers.tf": {
+			`resource "portkey_provider" "openai" {`,
+			`workspace_id   = portkey_workspace.prod.id`,
+			`integration_id = portkey_integration.openai.slug`,
+			`unit  = "rpm"`,
+			`id = "ws-uuid-1:prov-1"`,
+		},
+		"configs.tf": {
+			`config = jsonencode({`,
+			`workspace_id = portkey_workspace.prod.id`,
+			`provider = "@${portkey_provider.openai.slug}"`,
+			`virtual_key = portkey_provider.openai.id`,
+			`portkey_guardrail.pii.slug,`,
+			`mode = "fallback"`,
+			`model = "@${portkey_provider.openai.slug}/gpt-4o"`,
+			`user  = "pii"`,
+			`owner = "openai"`,
+		},
+		"guardrails.tf": {
+			`checks = jsonencode([`,
+			`rule = "$${secret}"`,
+			`deny = true`,
+		},
+	} {
+		content := string(files[name])
+		for _, want := range wants {
+			if !strings.Contains(content, want) {
+				t.Errorf("%s does not contain %q:\n%s", name, want, content)
+			}
+		}
+	}
+}
+
+func TestGenerate_Workspace(t *testing.T) {
+	files, err := Generate(context.Background(), newExportTestClient(t), "prod")
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	// The org-level integration is not part of the workspace export, so the
+	// provider refers to it by slug.
+	if _, ok := files["integrations.tf"]; ok {
+		t.Error("unexpected integrations.tf in a workspace export")
+	}
+	if want := `integration_id = "openai"`; !strings.Contains(string(files["providers.tf"]), want) {
+		t.Errorf("providers.tf does not contain %q:\n%s", want, files["providers.tf"])
+	}
+}
+
+func TestQuote(t *testing.T) {
+	for in, want := range map[string]string{
+		`plain`:          `"plain"`,
+		`say "hi"`:       `"say \"hi\""`,
+		"line\nbreak":    `"line\nbreak"`,
+		`${var.x}`:       `"$${var.x}"`,
+		`%{if x}`:        `"%%{if x}"`,
+		`cost $5 {`:      `"cost $5 {"`,
+		`back\slash`:     `"back\\slash"`,
+		"bell\a":         `"bell\u0007"`,
+		`emoji 🚀 works`: `"emoji 🚀 works"`,
+	} {
+		if got := quote(in); got != want {
+			t.Errorf("quote(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestResourceNames(t *testing.T) {
+	names := resourceNames{}
+	for _, tc := range []struct{ typ, hint, want string }{
+		{"portkey_config", "Prod Routing", "prod_routing"},
+		{"portkey_config", "prod-routing", "prod_routing_2"},
+		{"portkey_provider", "prod-routing", "prod_routing"},
+		{"portkey_config", "2024 config", "_2024_config"},
+		{"portkey_config", "!!!", "unnamed"},
+	} {
+		if got := names.name(tc.typ, tc.hint); got != tc.want {
+			t.Errorf("name(%q, %q) = %q, want %q", tc.typ, tc.hint, got, tc.want)
+		}
+	}
+}
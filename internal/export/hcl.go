@@ -0,0 +1,202 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// attribute is one argument of a generated resource block. value is an
+// already rendered HCL expression.
+type attribute struct {
+	name  string
+	value string
+}
+
+// tfFile accumulates the blocks of one generated .tf file.
+type tfFile struct {
+	buf bytes.Buffer
+}
+
+// resource appends a resource block and the import block that adopts the
+// existing object, optionally preceded by a comment line.
+func (f *tfFile) resource(typ, name, comment string, attrs []attribute, importID string) {
+	if f.buf.Len() > 0 {
+		f.buf.WriteString("\n")
+	}
+	if comment != "" {
+		fmt.Fprintf(&f.buf, "# %s\n", comment)
+	}
+	fmt.Fprintf(&f.buf, "resource %s %s {\n", quote(typ), quote(name))
+	for _, a := range attrs {
+		fmt.Fprintf(&f.buf, "%s = %s\n", a.name, a.value)
+	}
+	f.buf.WriteString("}\n\n")
+	fmt.Fprintf(&f.buf, "import {\nto = %s.%s\nid = %s\n}\n", typ, name, quote(importID))
+}
+
+// bytes returns the file contents formatted the way `terraform fmt` would.
+func (f *tfFile) bytes() []byte {
+	return hclwrite.Format(f.buf.Bytes())
+}
+
+// quote renders s as an HCL string literal. Template sequences are escaped so
+// that values such as "${var}" are written literally.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// objectKey renders an object key, bare when it is a valid identifier.
+func objectKey(k string) string {
+	switch {
+	case k == "null" || k == "true" || k == "false":
+		return quote(k)
+	case identifierPattern.MatchString(k):
+		return k
+	}
+	return quote(k)
+}
+
+// references maps literal values found in the API objects, such as a
+// provider slug, to the Terraform expressions that produce them.
+type references map[string]string
+
+// str renders a string, as a reference when s is a known value.
+func (refs references) str(s string) string {
+	if ref, ok := refs[s]; ok {
+		return ref
+	}
+	return quote(s)
+}
+
+// expression is an HCL expression placed in a decoded JSON value in lieu of
+// a string, rendered as is.
+type expression string
+
+// value renders a decoded JSON value as an HCL expression, replacing known
+// strings with references.
+func (refs references) value(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case expression:
+		return string(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	case string:
+		return refs.str(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return "[]"
+		}
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = refs.value(item)
+		}
+		return "[\n" + strings.Join(items, ",\n") + ",\n]"
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s = %s\n", objectKey(k), refs.value(v[k]))
+		}
+		b.WriteString("}")
+		return b.String()
+	}
+	return quote(fmt.Sprint(v))
+}
+
+// jsonencode renders v, any JSON-serialisable value, as a jsonencode() call
+// so the generated configuration stays readable and can hold references.
+func (refs references) jsonencode(v interface{}) (string, error) {
+	decoded, err := decodeJSON(v)
+	if err != nil {
+		return "", err
+	}
+	return "jsonencode(" + refs.value(decoded) + ")", nil
+}
+
+// decodeJSON returns v as generic JSON values, keeping numbers as written.
+func decodeJSON(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var decoded interface{}
+	if err := dec.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+var nonNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceNames hands out unique resource names per resource type.
+type resourceNames map[string]map[string]bool
+
+// name derives a resource name from hint, usually a slug or display name,
+// adding a numeric suffix when the name is already taken.
+func (n resourceNames) name(typ, hint string) string {
+	base := strings.Trim(nonNameChars.ReplaceAllString(strings.ToLower(hint), "_"), "_")
+	if base == "" {
+		base = "unnamed"
+	}
+	if base[0] >= '0' && base[0] <= '9' {
+		base = "_" + base
+	}
+
+	if n[typ] == nil {
+		n[typ] = map[string]bool{}
+	}
+	name := base
+	for i := 2; n[typ][name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	n[typ][name] = true
+	return name
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+func TestProvider_HasActions(t *testing.T) {
+	ctx := context.Background()
+
+	actions := New("test")().(provider.ProviderWithActions).Actions(ctx)
+	if len(actions) != 4 {
+		t.Errorf("Expected 4 actions, got %d", len(actions))
+	}
+	for _, f := range actions {
+		a := f()
+		var metadata action.MetadataResponse
+		a.Metadata(ctx, action.MetadataRequest{ProviderTypeName: "portkey"}, &metadata)
+		var schema action.SchemaResponse
+		a.Schema(ctx, action.SchemaRequest{}, &schema)
+		if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("%s: invalid schema: %v", metadata.TypeName, diags)
+		}
+	}
+}
+
+// invokeAction configures a with c and invokes it with the given attribute
+// values, leaving other attributes null. It returns the progress messages.
+func invokeAction(t *testing.T, a action.Action, c *client.Client, attrs map[string]tftypes.Value) []string {
+	t.Helper()
+	ctx := context.Background()
+
+	var configureResp action.ConfigureResponse
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: c}, &configureResp)
+
+	var schema action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schema)
+	typ := schema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		vals[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+		}
+	}
+
+	var messages []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) { messages = append(messages, event.Message) },
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Schema: schema.Schema, Raw: tftypes.NewValue(typ, vals)},
+	}, &resp)
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("Invoke: %v", resp.Diagnostics)
+	}
+	return messages
+}
+
+func TestActions_Invoke(t *testing.T) {
+	var requests []string
+	var bodies []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		if r.URL.Path == "/api-keys/key-1/rotate" {
+			_, _ = w.Write([]byte(`{"key":"pk-new","key_transition_expires_at":"2026-10-18T12:30:00Z"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		action  action.Action
+		attrs   map[string]tftypes.Value
+		request string
+		body    string
+		message string
+	}{
+		{
+			name:   "rotate",
+			action: NewRotateAPIKeyAction(),
+			attrs: map[string]tftypes.Value{
+				"api_key_id":           tftypes.NewValue(tftypes.String, "key-1"),
+				"transition_period_ms": tftypes.NewValue(tftypes.Number, 1800000),
+			},
+			request: "POST /api-keys/key-1/rotate",
+			body:    `{"key_transition_period_ms":1800000}`,
+			message: "Rotated API key key-1; the previous key value works until 2026-10-18T12:30:00Z",
+		},
+		{
+			name:    "reset usage",
+			action:  NewResetAPIKeyUsageAction(),
+			attrs:   map[string]tftypes.Value{"api_key_id": tftypes.NewValue(tftypes.String, "key-1")},
+			request: "PUT /api-keys/key-1",
+			body:    `{"reset_usage":true}`,
+			message: "Reset usage of API key key-1",
+		},
+		{
+			name:   "promote prompt version",
+			action: NewPromotePromptVersionAction(),
+			attrs: map[string]tftypes.Value{
+				"prompt_slug": tftypes.NewValue(tftypes.String, "support"),
+				"version":     tftypes.NewValue(tftypes.Number, 3),
+			},
+			request: "PUT /prompts/support/makeDefault",
+			body:    `{"version":3}`,
+			message: "Made version 3 of prompt support the default",
+		},
+		{
+			name:   "promote prompt partial version",
+			action: NewPromotePromptPartialVersionAction(),
+			attrs: map[string]tftypes.Value{
+				"prompt_partial_slug": tftypes.NewValue(tftypes.String, "tone"),
+				"version":             tftypes.NewValue(tftypes.Number, 2),
+			},
+			request: "PUT /prompts/partials/tone/makeDefault",
+			body:    `{"version":2}`,
+			message: "Made version 2 of prompt partial tone the default",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			requests, bodies = nil, nil
+			messages := invokeAction(t, tc.action, c, tc.attrs)
+
+			i := slices.Index(requests, tc.request)
+			if i < 0 {
+				t.Fatalf("requests = %v, want %s", requests, tc.request)
+			}
+			if body, _ := json.Marshal(bodies[i]); string(body) != tc.body {
+				t.Errorf("body = %s, want %s", body, tc.body)
+			}
+			if len(messages) != 1 || messages[0] != tc.message {
+				t.Errorf("messages = %q, want [%q]", messages, tc.message)
+			}
+		})
+	}
+}
+
+// TestRotateAPIKeyAction_unknownKey verifies that a key that cannot be looked
+// up is not rotated.
+func TestRotateAPIKeyAction_unknownKey(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	a := NewRotateAPIKeyAction()
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: c}, &action.ConfigureResponse{})
+	var schema action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schema)
+	typ := schema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	resp := action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Schema: schema.Schema, Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+			"api_key_id":           tftypes.NewValue(tftypes.String, "missing"),
+			"transition_period_ms": tftypes.NewValue(tftypes.Number, nil),
+		})},
+	}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an unknown key")
+	}
+	if want := []string{"GET /api-keys/missing"}; !slices.Equal(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}
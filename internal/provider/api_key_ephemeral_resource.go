@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &apiKeyEphemeralResource{}
+)
+
+// apiKeyEphemeralPrivateKey is the private data key holding the ID of the key
+// created by Open, which Close deletes.
+const apiKeyEphemeralPrivateKey = "api_key_id"
+
+// defaultEphemeralAPIKeyLifetime is the lifetime of ephemeral keys that set
+// neither expires_at nor expires_in.
+const defaultEphemeralAPIKeyLifetime = time.Hour
+
+// NewAPIKeyEphemeralResource is a helper function to simplify the provider implementation.
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyEphemeralResource{}
+}
+
+// apiKeyEphemeralResource creates a short-lived workspace API key for the
+// duration of a Terraform run, keeping the secret out of state and plan.
+type apiKeyEphemeralResource struct {
+	client *client.Client
+}
+
+// apiKeyEphemeralResourceModel maps the ephemeral resource schema data.
+type apiKeyEphemeralResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Key         types.String `tfsdk:"key"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	SubType     types.String `tfsdk:"sub_type"`
+	UserID      types.String `tfsdk:"user_id"`
+	Scopes      types.List   `tfsdk:"scopes"`
+	Metadata    types.Map    `tfsdk:"metadata"`
+	ConfigID    types.String `tfsdk:"config_id"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	ExpiresIn   types.String `tfsdk:"expires_in"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *apiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *apiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived workspace API key when Terraform opens the ephemeral resource and deletes it when Terraform closes it. " +
+			"The key is never written to state or plan files, which makes it suitable for throwaway CI credentials passed to provider " +
+			"configurations or other ephemeral values. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "API Key identifier (UUID).",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "The API key value.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Human-readable name for the API key (max 100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Optional description of the API key (max 200 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID or slug of the workspace the key belongs to.",
+				Required:    true,
+			},
+			"sub_type": schema.StringAttribute{
+				Description: "Sub-type of API key: 'service' (default) or 'user' (requires user_id).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("service", "user"),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "User ID for user-type keys. Required when sub_type is 'user'.",
+				Optional:    true,
+			},
+			"scopes": schema.ListAttribute{
+				Description: "Permission scopes granted to the key. At least one scope is required so that keys are created with only the access they need.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Custom metadata attached to requests made with the key, e.g. the CI job ID.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"config_id": schema.StringAttribute{
+				Description: "ID of a config to apply by default to requests made with the key.",
+				Optional:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiration time of the key in RFC3339 format. Computed from expires_in when not set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("expires_in")),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				Description: "Lifetime of the key as a duration such as '30m' or '2h', counted from when it is created. Defaults to 1h when expires_at is not set. " +
+					"The key expires on its own even if Terraform is interrupted before deleting it.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *apiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Open creates the API key.
+func (r *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subType := "service"
+	if !data.SubType.IsNull() {
+		subType = data.SubType.ValueString()
+	}
+	if subType == "user" && data.UserID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_id"),
+			"Missing Required Field",
+			"user_id is required when sub_type is 'user'",
+		)
+		return
+	}
+
+	expiresAt := data.ExpiresAt.ValueString()
+	if data.ExpiresAt.IsNull() {
+		lifetime := defaultEphemeralAPIKeyLifetime
+		if !data.ExpiresIn.IsNull() {
+			var err error
+			lifetime, err = time.ParseDuration(data.ExpiresIn.ValueString())
+			if err != nil || lifetime <= 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("expires_in"),
+					"Invalid Duration",
+					fmt.Sprintf("expires_in must be a positive duration such as '30m' or '2h', got %q.", data.ExpiresIn.ValueString()),
+				)
+				return
+			}
+		}
+		expiresAt = time.Now().Add(lifetime).UTC().Format(time.RFC3339)
+	}
+
+	createReq := client.CreateAPIKeyRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		WorkspaceID: data.WorkspaceID.ValueString(),
+		UserID:      data.UserID.ValueString(),
+		ExpiresAt:   expiresAt,
+	}
+
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &createReq.Scopes, false)...)
+	if !data.Metadata.IsNull() {
+		createReq.Defaults = &client.APIKeyDefaults{}
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &createReq.Defaults.Metadata, false)...)
+	}
+	if !data.ConfigID.IsNull() {
+		if createReq.Defaults == nil {
+			createReq.Defaults = &client.APIKeyDefaults{}
+		}
+		createReq.Defaults.ConfigID = data.ConfigID.ValueString()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := r.client.CreateAPIKey(ctx, "workspace", subType, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API key",
+			"Could not create ephemeral API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Close finds the key to delete in private data.
+	privateID, _ := json.Marshal(createResp.ID)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyEphemeralPrivateKey, privateID)...)
+
+	data.ID = types.StringValue(createResp.ID)
+	data.Key = types.StringValue(createResp.Key)
+	data.ExpiresAt = types.StringValue(expiresAt)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deletes the API key created by Open.
+func (r *apiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateID, diags := req.Private.GetKey(ctx, apiKeyEphemeralPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateID == nil {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(privateID, &id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Portkey API Key",
+			"Could not decode the ID of the ephemeral API key: "+err.Error(),
+		)
+		return
+	}
+
+	if err := r.client.DeleteAPIKey(ctx, id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Portkey API Key",
+			"Could not delete ephemeral API key "+id+", unexpected error: "+err.Error()+
+				"\n\nThe key stays valid until its expires_at.",
+		)
+	}
+}
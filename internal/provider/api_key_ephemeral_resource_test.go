@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProvider_HasEphemeralResources(t *testing.T) {
+	ctx := context.Background()
+
+	ephemeralResources := New("test")().(provider.ProviderWithEphemeralResources).EphemeralResources(ctx)
+	if len(ephemeralResources) != 1 {
+		t.Fatalf("Expected 1 ephemeral resource, got %d", len(ephemeralResources))
+	}
+
+	var schema ephemeral.SchemaResponse
+	ephemeralResources[0]().Schema(ctx, ephemeral.SchemaRequest{}, &schema)
+	if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Errorf("invalid ephemeral resource schema: %v", diags)
+	}
+}
+
+// objectValue builds a value of the object type typ, leaving attributes not
+// in attrs null.
+func objectValue(t *testing.T, typ tftypes.Type, attrs map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	objType := typ.(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, attrType := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+		}
+	}
+	dv, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, vals))
+	if err != nil {
+		t.Fatalf("NewDynamicValue: %v", err)
+	}
+	return &dv
+}
+
+func TestAPIKeyEphemeralResource_OpenClose(t *testing.T) {
+	ctx := context.Background()
+
+	var created map[string]interface{}
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api-keys/workspace/service":
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"id":"key-1","key":"pk-secret"}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("NewProtocol6WithError: %v", err)
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: objectValue(t, schemas.Provider.ValueType(), map[string]tftypes.Value{
+			"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+			"base_url": tftypes.NewValue(tftypes.String, srv.URL),
+		}),
+	})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider: %v %v", err, configureResp.Diagnostics)
+	}
+
+	keyType := schemas.EphemeralResourceSchemas["portkey_api_key"].ValueType()
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "portkey_api_key",
+		Config: objectValue(t, keyType, map[string]tftypes.Value{
+			"name":         tftypes.NewValue(tftypes.String, "ci-run-42"),
+			"workspace_id": tftypes.NewValue(tftypes.String, "ws-uuid-1"),
+			"scopes":       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "completions.write")}),
+			"expires_in":   tftypes.NewValue(tftypes.String, "30m"),
+		}),
+	})
+	if err != nil || len(openResp.Diagnostics) > 0 {
+		t.Fatalf("OpenEphemeralResource: %v %v", err, openResp.Diagnostics)
+	}
+
+	result, err := openResp.Result.Unmarshal(keyType)
+	if err != nil {
+		t.Fatalf("Unmarshal result: %v", err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := result.As(&attrs); err != nil {
+		t.Fatalf("result.As: %v", err)
+	}
+	var key, expiresAt string
+	_ = attrs["key"].As(&key)
+	_ = attrs["expires_at"].As(&expiresAt)
+	if key != "pk-secret" {
+		t.Errorf("key = %q, want %q", key, "pk-secret")
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		t.Fatalf("expires_at %q is not RFC3339: %v", expiresAt, err)
+	}
+	if d := time.Until(expires); d <= 25*time.Minute || d > 30*time.Minute {
+		t.Errorf("expires_at is %s away, want about 30m", d)
+	}
+	if created["workspace_id"] != "ws-uuid-1" || created["expires_at"] != expiresAt {
+		t.Errorf("unexpected create request: %v", created)
+	}
+
+	closeResp, err := server.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "portkey_api_key",
+		Private:  openResp.Private,
+	})
+	if err != nil || len(closeResp.Diagnostics) > 0 {
+		t.Fatalf("CloseEphemeralResource: %v %v", err, closeResp.Diagnostics)
+	}
+	if len(deleted) != 1 || deleted[0] != "/api-keys/key-1" {
+		t.Errorf("deleted = %v, want [/api-keys/key-1]", deleted)
+	}
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewAPIKeyListResource lists API keys for `terraform query`.
+func NewAPIKeyListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.APIKey]{
+		typeName:       "api_key",
+		kind:           "API keys",
+		title:          "API Keys",
+		workspaceScope: listWorkspaceOptional,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.APIKey, error) {
+			return c.ListAPIKeys(ctx, workspaceID)
+		},
+		fields: map[string]listResourceField[client.APIKey]{
+			"name":   {"name", func(k client.APIKey) string { return k.Name }},
+			"type":   {"type (`organisation-service`, `workspace-user`, ...)", func(k client.APIKey) string { return k.Type }},
+			"status": {"status", func(k client.APIKey) string { return k.Status }},
+		},
+		identity: func(k client.APIKey, _ string) map[string]string {
+			return map[string]string{"id": k.ID}
+		},
+		displayName: func(k client.APIKey) string { return k.Name },
+		resource:    NewAPIKeyResource,
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
@@ -27,7 +28,9 @@ var (
 	_ resource.Resource                = &apiKeyResource{}
 	_ resource.ResourceWithConfigure   = &apiKeyResource{}
 	_ resource.ResourceWithImportState = &apiKeyResource{}
+	_ resource.ResourceWithMoveState   = &apiKeyResource{}
 	_ resource.ResourceWithModifyPlan  = &apiKeyResource{}
+	_ resource.ResourceWithIdentity    = &apiKeyResource{}
 )
 
 // NewAPIKeyResource is a helper function to simplify the provider implementation.
@@ -50,6 +53,7 @@ type apiKeyResourceModel struct {
 	SubType             types.String `tfsdk:"sub_type"`
 	OrganisationID      types.String `tfsdk:"organisation_id"`
 	WorkspaceID         types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug       types.String `tfsdk:"workspace_slug"`
 	UserID              types.String `tfsdk:"user_id"`
 	Status              types.String `tfsdk:"status"`
 	Scopes              types.List   `tfsdk:"scopes"`
@@ -70,11 +74,19 @@ type apiKeyResourceModel struct {
 	// RotateTransitionPeriodMs is the optional transition window (in ms) sent
 	// with the next on-demand rotation. Only consulted when rotate_trigger fires.
 	RotateTransitionPeriodMs types.Int64 `tfsdk:"rotate_transition_period_ms"`
+	// RotateEvery is the cadence (a Go duration) at which ModifyPlan schedules
+	// an on-demand rotation, measured from LastRotatedAt or, before the first
+	// rotation, from CreatedAt.
+	RotateEvery types.String `tfsdk:"rotate_every"`
+	// LastRotatedAt records when the provider last rotated the key on demand.
+	// Null until a rotation occurs; never returned by the API.
+	LastRotatedAt types.String `tfsdk:"last_rotated_at"`
 	// KeyTransitionExpiresAt is the API-returned cut-off for the previous key
 	// after the most recent on-demand rotation. Null until a rotation occurs.
 	KeyTransitionExpiresAt types.String `tfsdk:"key_transition_expires_at"`
 	CreatedAt              types.String `tfsdk:"created_at"`
 	UpdatedAt              types.String `tfsdk:"updated_at"`
+	DeletionProtection     types.Bool   `tfsdk:"deletion_protection"`
 }
 
 // Metadata returns the resource type name.
@@ -91,6 +103,12 @@ func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataReques
 // key (Computed preserves it in state); we fall back to the state value in that case.
 // Skip when either attribute is Unknown (expression not yet resolved at plan time).
 func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+	modifyDeletionProtectionPlan(ctx, req, resp, r.client, "API Key")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Nothing to validate on destroy.
 	if req.Plan.Raw.IsNull() {
 		return
@@ -171,35 +189,10 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 
 	// -------------------------------------------------------------------------
 	// 3. usage_limits: credit_limit is required when the block is present;
-	//    periodic_reset and periodic_reset_days are mutually exclusive.
-	//    Skip individual attribute checks when the value is Unknown (unresolved
-	//    expression) — validation will re-run at apply time once values are known.
+	//    periodic_reset and periodic_reset_days are mutually exclusive;
+	//    alert_threshold must not exceed credit_limit.
 	// -------------------------------------------------------------------------
-	if !config.UsageLimits.IsNull() && !config.UsageLimits.IsUnknown() {
-		attrs := config.UsageLimits.Attributes()
-
-		creditLimit, hasCL := attrs["credit_limit"]
-		// Only enforce when credit_limit is known-null (deliberately omitted), not Unknown.
-		if !hasCL || (creditLimit.IsNull() && !creditLimit.IsUnknown()) {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("usage_limits").AtName("credit_limit"),
-				"Missing Required Attribute",
-				"credit_limit is required when usage_limits is configured.",
-			)
-		}
-
-		periodicReset := attrs["periodic_reset"]
-		periodicResetDays := attrs["periodic_reset_days"]
-		periodicResetSet := periodicReset != nil && !periodicReset.IsNull() && !periodicReset.IsUnknown()
-		periodicResetDaysSet := periodicResetDays != nil && !periodicResetDays.IsNull() && !periodicResetDays.IsUnknown()
-		if periodicResetSet && periodicResetDaysSet {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("usage_limits").AtName("periodic_reset_days"),
-				"Conflicting Attributes",
-				"periodic_reset and periodic_reset_days are mutually exclusive. Set one or the other, not both.",
-			)
-		}
-	}
+	resp.Diagnostics.Append(validateAPIKeyUsageLimitsConfig(config.UsageLimits, path.Root("usage_limits"))...)
 
 	// -------------------------------------------------------------------------
 	// 4. expires_at: validate it is a non-empty RFC3339 datetime when explicitly set.
@@ -215,6 +208,21 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		}
 	}
 
+	// -------------------------------------------------------------------------
+	// 4b. rotate_every: a Go duration of at least one hour, so a rotation never
+	//     falls inside the 30-minute minimum transition period of the last one.
+	// -------------------------------------------------------------------------
+	if !config.RotateEvery.IsNull() && !config.RotateEvery.IsUnknown() {
+		val := config.RotateEvery.ValueString()
+		if d, err := time.ParseDuration(val); err != nil || d < time.Hour {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rotate_every"),
+				"Invalid Rotation Interval",
+				fmt.Sprintf("rotate_every must be a duration of at least 1h, such as \"720h\", got: %q", val),
+			)
+		}
+	}
+
 	// -------------------------------------------------------------------------
 	// 5. rotation_policy: rotation_period and next_rotation_at are mutually
 	//    exclusive per the API spec. Also validate next_rotation_at is RFC3339.
@@ -250,35 +258,7 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	}
 
 	// -------------------------------------------------------------------------
-	// 6. usage_limits: alert_threshold must not exceed credit_limit.
-	// -------------------------------------------------------------------------
-	if !config.UsageLimits.IsNull() && !config.UsageLimits.IsUnknown() {
-		attrs := config.UsageLimits.Attributes()
-
-		creditLimitAttr := attrs["credit_limit"]
-		alertThresholdAttr := attrs["alert_threshold"]
-
-		creditLimitKnown := creditLimitAttr != nil && !creditLimitAttr.IsNull() && !creditLimitAttr.IsUnknown()
-		alertThresholdKnown := alertThresholdAttr != nil && !alertThresholdAttr.IsNull() && !alertThresholdAttr.IsUnknown()
-
-		if creditLimitKnown && alertThresholdKnown {
-			if clVal, ok := creditLimitAttr.(types.Int64); ok {
-				if atVal, ok := alertThresholdAttr.(types.Int64); ok {
-					if atVal.ValueInt64() > clVal.ValueInt64() {
-						resp.Diagnostics.AddAttributeError(
-							path.Root("usage_limits").AtName("alert_threshold"),
-							"Invalid Attribute Value",
-							fmt.Sprintf("alert_threshold (%d) must be less than or equal to credit_limit (%d).",
-								atVal.ValueInt64(), clVal.ValueInt64()),
-						)
-					}
-				}
-			}
-		}
-	}
-
-	// -------------------------------------------------------------------------
-	// 7. reset_usage: write-only trigger (Optional only, not Computed).
+	// 6. reset_usage: write-only trigger (Optional only, not Computed).
 	//    Only mark last_reset_at / updated_at Unknown when the reset is truly
 	//    new — i.e. config=true but the prior state did NOT already have true.
 	//    During a no-op non-refresh plan (config=true, state=true after apply),
@@ -295,7 +275,7 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	}
 
 	// -------------------------------------------------------------------------
-	// 8a. config_id clearing: when the user removes config_id from HCL while
+	// 7a. config_id clearing: when the user removes config_id from HCL while
 	//     the prior state holds a bound config UUID, explicitly null the plan.
 	//     Without this, UseStateForUnknown would copy the old UUID into the plan,
 	//     but the provider will return null after clearing → "inconsistent result".
@@ -306,7 +286,7 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	}
 
 	// -------------------------------------------------------------------------
-	// 8b. allow_config_override clearing: when the user removes the attribute
+	// 7b. allow_config_override clearing: when the user removes the attribute
 	//     from HCL while prior state was non-null, explicitly null the plan so
 	//     the Update handler can write null without an "inconsistent result".
 	// -------------------------------------------------------------------------
@@ -316,7 +296,7 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	}
 
 	// -------------------------------------------------------------------------
-	// 9. updated_at + rotation_policy.next_rotation_at consistency.
+	// 8. updated_at + rotation_policy.next_rotation_at consistency.
 	//
 	//    The TF Framework keeps the prior Computed value in the plan for Updates.
 	//    If the API's write timestamp differs from that prior value (e.g. the
@@ -366,7 +346,7 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		}
 
 		// -------------------------------------------------------------------------
-		// 10. rotate_trigger: bumping the trigger value calls the /rotate endpoint
+		// 9. rotate_trigger: bumping the trigger value calls the /rotate endpoint
 		//     during Update, which returns a new `key` and a fresh
 		//     `key_transition_expires_at`. Mark those Computed attributes Unknown
 		//     so the post-apply state can carry the API-returned values without
@@ -380,6 +360,19 @@ func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		rotateTriggered := !config.RotateTrigger.Equal(priorState.RotateTrigger) &&
 			!config.RotateTrigger.IsNull() && !config.RotateTrigger.IsUnknown()
 
+		// rotate_every: rotation is also due once the interval has elapsed
+		// since the last on-demand rotation (or since creation). Update reads
+		// the Unknown last_rotated_at as the signal to rotate, so the value is
+		// pinned to prior state otherwise.
+		if !rotateTriggered && apiKeyRotationDue(config.RotateEvery, priorState.LastRotatedAt, priorState.CreatedAt, time.Now()) {
+			rotateTriggered = true
+		}
+		if rotateTriggered {
+			resp.Plan.SetAttribute(ctx, path.Root("last_rotated_at"), types.StringUnknown())
+		} else {
+			resp.Plan.SetAttribute(ctx, path.Root("last_rotated_at"), priorState.LastRotatedAt)
+		}
+
 		if scalarChange || rotationChange || rotateTriggered {
 			resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())
 		}
@@ -415,7 +408,7 @@ API Key Types:
 				},
 			},
 			"key": schema.StringAttribute{
-				Description: "The actual API key value. Only returned on creation and stored in state.",
+				Description: "The actual API key value. Returned on creation and on every on-demand rotation; otherwise preserved from prior state.",
 				Computed:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
@@ -457,10 +450,8 @@ API Key Types:
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID. Required for workspace API keys (type='workspace'). Not used for Admin API keys.",
 				Optional:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"user_id": schema.StringAttribute{
 				Description: "User ID for user-type keys. Required when sub_type is 'user'.",
 				Optional:    true,
@@ -680,6 +671,21 @@ API Key Types:
 					int64validator.AtLeast(1800000),
 				},
 			},
+			"rotate_every": schema.StringAttribute{
+				Description: "Rotation cadence as a duration of at least 1h, such as `720h`. Every plan compares it against " +
+					"`last_rotated_at` (or `created_at` before the first rotation) and, once the interval has elapsed, plans an " +
+					"on-demand rotation that uses `rotate_transition_period_ms`. Unlike `rotation_policy`, any cadence is " +
+					"possible, but rotation only happens when Terraform is applied, e.g. from a scheduled pipeline.",
+				Optional: true,
+			},
+			"last_rotated_at": schema.StringAttribute{
+				Description: "Timestamp (RFC3339) of the most recent on-demand rotation made by `rotate_trigger` or `rotate_every`. " +
+					"Null until the first rotation.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"key_transition_expires_at": schema.StringAttribute{
 				Description: "Timestamp (RFC3339) at which the previous key value stops being accepted after the most recent " +
 					"on-demand rotation. Populated only after `rotate_trigger` has fired at least once; null otherwise.",
@@ -696,6 +702,16 @@ API Key Types:
 				Description: "Timestamp when the API key was last updated.",
 				Computed:    true,
 			},
+			"deletion_protection": deletionProtectionAttribute(),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *apiKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("API key identifier (UUID)."),
 		},
 	}
 }
@@ -909,22 +925,12 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	// [] and the plan had null/unknown (user never configured rate_limits), store
 	// null so that subsequent anyChange comparisons don't see a spurious diff
 	// (null in config vs [] in state → false positive → updated_at = (known after apply)).
-	if apiKey.RateLimits == nil {
-		plan.RateLimits = types.ListNull(apiKeyRateLimitsObjectType)
-	} else if len(apiKey.RateLimits) == 0 {
-		if plan.RateLimits.IsNull() || plan.RateLimits.IsUnknown() {
-			plan.RateLimits = types.ListNull(apiKeyRateLimitsObjectType)
-		} else {
-			plan.RateLimits = types.ListValueMust(apiKeyRateLimitsObjectType, []attr.Value{})
-		}
-	} else {
-		rlList, rlDiags := apiKeyRateLimitsToTerraformList(apiKey.RateLimits)
-		resp.Diagnostics.Append(rlDiags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		plan.RateLimits = rlList
+	rlList, rlDiags := reconcileAPIKeyRateLimits(plan.RateLimits, apiKey.RateLimits)
+	resp.Diagnostics.Append(rlDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	plan.RateLimits = rlList
 
 	// Handle metadata from API.
 	// For Optional (non-Computed) attributes the post-apply state must match the
@@ -1019,8 +1025,10 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	// rotate_trigger and rotate_transition_period_ms already hold the config
 	// values from req.Plan.Get above. They are user-driven inputs; Create never
 	// triggers a rotation (the key is fresh).
-	// key_transition_expires_at: Computed with no rotation yet → must be null.
+	// key_transition_expires_at and last_rotated_at: Computed with no rotation
+	// yet → must be null.
 	plan.KeyTransitionExpiresAt = types.StringNull()
+	plan.LastRotatedAt = types.StringNull()
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -1028,6 +1036,8 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -1100,63 +1110,23 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		state.Scopes = types.ListValueMust(types.StringType, []attr.Value{})
 	}
 
-	// Handle usage_limits from API.
-	// When prior state has usage_limits, merge: preserve user-configured Optional
-	// fields from prior state (avoids stale API data overwriting values just
-	// applied) and only update the server-computed next_usage_reset_at from the
-	// API response. When prior state is null (Create, Import, or just-cleared),
-	// use the full API value.
-	if apiKey.UsageLimits != nil {
-		if !state.UsageLimits.IsNull() && !state.UsageLimits.IsUnknown() {
-			priorUL := terraformToAPIKeyUsageLimits(state.UsageLimits)
-			mergedUL := &client.UsageLimits{
-				Type:              priorUL.Type,
-				CreditLimit:       priorUL.CreditLimit,
-				AlertThreshold:    priorUL.AlertThreshold,
-				PeriodicReset:     priorUL.PeriodicReset,
-				PeriodicResetDays: priorUL.PeriodicResetDays,
-				NextUsageResetAt:  apiKey.UsageLimits.NextUsageResetAt,
-			}
-			ulObj, ulDiags := apiKeyUsageLimitsToTerraform(mergedUL)
-			resp.Diagnostics.Append(ulDiags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			state.UsageLimits = ulObj
-		} else {
-			ulObj, ulDiags := apiKeyUsageLimitsToTerraform(apiKey.UsageLimits)
-			resp.Diagnostics.Append(ulDiags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			state.UsageLimits = ulObj
-		}
-	} else {
-		state.UsageLimits = types.ObjectNull(apiKeyUsageLimitsAttrTypes)
+	// Handle usage_limits from API (see reconcileAPIKeyUsageLimits for the
+	// prior-state merge that protects against stale API reads).
+	ulObj, ulDiags := reconcileAPIKeyUsageLimits(state.UsageLimits, apiKey.UsageLimits)
+	resp.Diagnostics.Append(ulDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	state.UsageLimits = ulObj
 
-	// Handle rate_limits from API.
-	// Distinguish nil (field absent → null) from non-nil empty (API returns [] for
-	// every key regardless of whether rate_limits was ever configured).
-	// When the API returns [] and the prior state was null (user never configured
-	// rate_limits), keep null to prevent a perpetual "empty list vs null" diff
-	// and to avoid plan inconsistency in subsequent update steps.
-	if apiKey.RateLimits == nil {
-		state.RateLimits = types.ListNull(apiKeyRateLimitsObjectType)
-	} else if len(apiKey.RateLimits) == 0 {
-		// API returned empty rate_limits. If the prior state was null, preserve null.
-		if !state.RateLimits.IsNull() {
-			state.RateLimits = types.ListValueMust(apiKeyRateLimitsObjectType, []attr.Value{})
-		}
-		// else: state.RateLimits stays null (prior state was null → keep it null)
-	} else {
-		rlList, rlDiags := apiKeyRateLimitsToTerraformList(apiKey.RateLimits)
-		resp.Diagnostics.Append(rlDiags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		state.RateLimits = rlList
+	// Handle rate_limits from API (see reconcileAPIKeyRateLimits for the
+	// nil-vs-empty handling that prevents a perpetual "[] vs null" diff).
+	rlList, rlDiags := reconcileAPIKeyRateLimits(state.RateLimits, apiKey.RateLimits)
+	resp.Diagnostics.Append(rlDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	state.RateLimits = rlList
 
 	// Handle metadata from API.
 	// Distinguish nil (field absent → null) from non-nil empty (API returns {} for
@@ -1265,18 +1235,28 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// a diff (config=true, state=null) on every refresh → non-empty plan.
 	// The value only transitions true→null when the user removes it from HCL.
 
-	// rotate_trigger, rotate_transition_period_ms, and key_transition_expires_at
-	// are NOT returned by GET /api-keys/{id}. They are managed locally:
-	//   - rotate_trigger / rotate_transition_period_ms: user inputs, preserved as-is.
-	//   - key_transition_expires_at: populated only by RotateAPIKey responses.
+	// rotate_trigger, rotate_transition_period_ms, rotate_every,
+	// key_transition_expires_at and last_rotated_at are NOT returned by
+	// GET /api-keys/{id}. They are managed locally:
+	//   - rotate_trigger / rotate_transition_period_ms / rotate_every: user
+	//     inputs, preserved as-is.
+	//   - key_transition_expires_at / last_rotated_at: populated only by
+	//     RotateAPIKey calls.
 	// Leaving the existing state values untouched ensures Read does not zero them.
 
+	// deletion_protection is provider-side only; fill in the default after import.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(defaultDeletionProtection(r.client))
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -1635,9 +1615,10 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	//
 	// Fires when the user-controlled rotate_trigger string changes between the
 	// prior state and the new config (null→value also counts; value→null does
-	// not). Mirrors the detection used in ModifyPlan so the Computed attributes
-	// it marked Unknown — `key`, `key_transition_expires_at`, `updated_at` —
-	// match what we set here.
+	// not), or when rotate_every is due. Mirrors the detection used in
+	// ModifyPlan so the Computed attributes it marked Unknown — `key`,
+	// `key_transition_expires_at`, `last_rotated_at`, `updated_at` — match
+	// what we set here.
 	//
 	// Order: any field-level UpdateAPIKey changes are applied first (above);
 	// the rotation runs last so the new key value and transition timestamp
@@ -1645,6 +1626,8 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// -------------------------------------------------------------------------
 	rotateTriggered := !config.RotateTrigger.Equal(state.RotateTrigger) &&
 		!config.RotateTrigger.IsNull() && !config.RotateTrigger.IsUnknown()
+	// ModifyPlan marks last_rotated_at Unknown when rotate_every is due.
+	rotateTriggered = rotateTriggered || plan.LastRotatedAt.IsUnknown()
 
 	if rotateTriggered {
 		var rotateReq *client.RotateAPIKeyRequest
@@ -1670,6 +1653,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 
 		plan.Key = types.StringValue(rotateResp.Key)
+		plan.LastRotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 		if rotateResp.KeyTransitionExpiresAt != "" {
 			plan.KeyTransitionExpiresAt = types.StringValue(rotateResp.KeyTransitionExpiresAt)
 		} else {
@@ -1700,6 +1684,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		// UseStateForUnknown carry-over, but make the intent explicit so future
 		// edits don't accidentally null it out.
 		plan.KeyTransitionExpiresAt = state.KeyTransitionExpiresAt
+		plan.LastRotatedAt = state.LastRotatedAt
 	}
 
 	diags = resp.State.Set(ctx, plan)
@@ -1707,6 +1692,8 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -1719,6 +1706,10 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if !checkDeletionProtection(state.DeletionProtection, "API Key", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
 	// Delete existing API key
 	err := r.client.DeleteAPIKey(ctx, state.ID.ValueString())
 	if err != nil {
@@ -1733,7 +1724,37 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 // ImportState imports the resource state.
 func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import by ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// MoveState moves portkey_api_key state from another provider and restapi_object API keys.
+func (r *apiKeyResource) MoveState(_ context.Context) []resource.StateMover {
+	return importStateMovers(r,
+		attributeSource("portkey_api_key", "%s", []string{"id"}),
+		restapiObjectSource("/api-keys(/[a-z]+){0,2}", restapiObjectID),
+	)
+}
+
+// apiKeyRotationDue reports whether rotate_every has elapsed at now since the
+// last on-demand rotation, or since creation when the key was never rotated.
+func apiKeyRotationDue(rotateEvery, lastRotatedAt, createdAt types.String, now time.Time) bool {
+	if rotateEvery.IsNull() || rotateEvery.IsUnknown() {
+		return false
+	}
+	every, err := time.ParseDuration(rotateEvery.ValueString())
+	if err != nil || every <= 0 {
+		return false
+	}
+
+	since := lastRotatedAt
+	if since.IsNull() || since.IsUnknown() {
+		since = createdAt
+	}
+	last, err := time.Parse(time.RFC3339, since.ValueString())
+	if err != nil {
+		return false
+	}
+	return !now.Before(last.Add(every))
 }
 
 // parseAPIKeyType parses the combined type field (e.g., "organisation-service") into type and sub_type
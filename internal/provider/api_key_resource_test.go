@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -1363,3 +1365,52 @@ resource "portkey_api_key" "test" {
 %[3]s}
 `, name, trigger, transitionLine)
 }
+
+// TestAccAPIKeyResource_rotateEveryInvalid verifies that rotate_every must be
+// a duration of at least one hour.
+func TestAccAPIKeyResource_rotateEveryInvalid(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-ak-rotate-every")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "portkey_api_key" "test" {
+  name         = %[1]q
+  type         = "organisation"
+  sub_type     = "service"
+  scopes       = ["providers.list"]
+  rotate_every = "30m"
+}
+`, name),
+				ExpectError: regexp.MustCompile(`rotate_every must be a duration of at least 1h`),
+			},
+		},
+	})
+}
+
+func TestAPIKeyRotationDue(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	created := types.StringValue("2026-09-01T12:00:00Z")
+
+	for _, tc := range []struct {
+		name        string
+		rotateEvery types.String
+		lastRotated types.String
+		want        bool
+	}{
+		{"unset", types.StringNull(), types.StringNull(), false},
+		{"unknown", types.StringUnknown(), types.StringNull(), false},
+		{"due since creation", types.StringValue("720h"), types.StringNull(), true},
+		{"not due since creation", types.StringValue("1200h"), types.StringNull(), false},
+		{"not due since rotation", types.StringValue("720h"), types.StringValue("2026-10-01T00:00:00Z"), false},
+		{"due since rotation", types.StringValue("24h"), types.StringValue("2026-10-17T12:00:00Z"), true},
+		{"invalid duration", types.StringValue("monthly"), types.StringNull(), false},
+	} {
+		if got := apiKeyRotationDue(tc.rotateEvery, tc.lastRotated, created, now); got != tc.want {
+			t.Errorf("%s: apiKeyRotationDue = %t, want %t", tc.name, got, tc.want)
+		}
+	}
+}
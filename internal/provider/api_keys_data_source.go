@@ -30,6 +30,8 @@ type apiKeysDataSource struct {
 type apiKeysDataSourceModel struct {
 	WorkspaceID types.String          `tfsdk:"workspace_id"`
 	APIKeys     []apiKeyDataItemModel `tfsdk:"api_keys"`
+	Filter      []listFilterModel     `tfsdk:"filter"`
+	IDs         types.List            `tfsdk:"ids"`
 }
 
 // apiKeyDataItemModel maps individual API key data.
@@ -183,6 +185,10 @@ func (d *apiKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 				},
 			},
+			"ids": listIDsAttribute("API keys"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("API keys"),
 		},
 	}
 }
@@ -335,6 +341,12 @@ func (d *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		state.APIKeys = append(state.APIKeys, keyItem)
 	}
 
+	state.APIKeys = applyListFilters(state.APIKeys, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.APIKeys, "id")
+
 	// Set state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
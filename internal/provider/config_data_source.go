@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
@@ -52,23 +55,30 @@ func (d *configDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 		Description: "Use this data source to get information about a Portkey config.",
 		Attributes: map[string]schema.Attribute{
 			"slug": schema.StringAttribute{
-				Description: "The slug of the config to look up.",
-				Required:    true,
+				Description: "The slug of the config to look up. Exactly one of slug or name must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"id": schema.StringAttribute{
 				Description: "Config identifier (UUID).",
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Human-readable name for the config.",
-				Computed:    true,
+				Description: "Human-readable name for the config. Set it instead of slug to look the config up by name; " +
+					"exactly one config (in workspace_id, when set) must have this name.",
+				Optional: true,
+				Computed: true,
 			},
 			"config": schema.StringAttribute{
 				Description: "JSON configuration object.",
 				Computed:    true,
 			},
 			"workspace_id": schema.StringAttribute{
-				Description: "Workspace ID the config belongs to.",
+				Description: "Workspace ID the config belongs to. When looking up by name, only this workspace is searched.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"is_default": schema.BoolAttribute{
@@ -123,7 +133,32 @@ func (d *configDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	config, err := d.client.GetConfig(ctx, state.Slug.ValueString())
+	slug := state.Slug.ValueString()
+	if !state.Name.IsNull() {
+		configs, err := d.client.ListConfigs(ctx, state.WorkspaceID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Portkey Configs",
+				err.Error(),
+			)
+			return
+		}
+
+		name := state.Name.ValueString()
+		match, err := findOneByName(configs, "config", name, func(v client.Config) bool {
+			return v.Name == name
+		}, func(v client.Config) string { return v.Slug })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Find Portkey Config",
+				err.Error(),
+			)
+			return
+		}
+		slug = match.Slug
+	}
+
+	config, err := d.client.GetConfig(ctx, slug)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Portkey Config",
@@ -136,7 +171,10 @@ func (d *configDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	state.ID = types.StringValue(config.ID)
 	state.Slug = types.StringValue(config.Slug)
 	state.Name = types.StringValue(config.Name)
-	state.WorkspaceID = types.StringValue(config.WorkspaceID)
+	// Keep a configured workspace_id as-is; it may be a slug.
+	if state.WorkspaceID.IsNull() {
+		state.WorkspaceID = types.StringValue(config.WorkspaceID)
+	}
 	state.IsDefault = types.BoolValue(config.IsDefault == 1)
 	state.Status = types.StringValue(config.Status)
 	state.VersionID = types.StringValue(config.VersionID)
@@ -30,6 +30,25 @@ func TestAccConfigDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccConfigDataSource_byName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-ds-name")
+	workspaceID := getTestWorkspaceID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigDataSourceConfigByName(rName, workspaceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.portkey_config.test", "slug", "portkey_config.test", "slug"),
+					resource.TestCheckResourceAttr("data.portkey_config.test", "name", rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccConfigDataSourceConfig(name, workspaceID string) string {
 	return fmt.Sprintf(`
 provider "portkey" {}
@@ -45,3 +64,21 @@ data "portkey_config" "test" {
 }
 `, name, workspaceID)
 }
+
+func testAccConfigDataSourceConfigByName(name, workspaceID string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_config" "test" {
+  name         = %[1]q
+  workspace_id = %[2]q
+  config       = "{\"retry\":{\"attempts\":3}}"
+}
+
+data "portkey_config" "test" {
+  name         = %[1]q
+  workspace_id = %[2]q
+  depends_on   = [portkey_config.test]
+}
+`, name, workspaceID)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewConfigListResource lists configs for `terraform query`.
+func NewConfigListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.Config]{
+		typeName:       "config",
+		kind:           "configs",
+		title:          "Configs",
+		workspaceScope: listWorkspaceOptional,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.Config, error) {
+			return c.ListConfigs(ctx, workspaceID)
+		},
+		fields: map[string]listResourceField[client.Config]{
+			"name":   {"name", func(cfg client.Config) string { return cfg.Name }},
+			"slug":   {"slug", func(cfg client.Config) string { return cfg.Slug }},
+			"status": {"status", func(cfg client.Config) string { return cfg.Status }},
+		},
+		identity: func(cfg client.Config, workspaceID string) map[string]string {
+			if cfg.WorkspaceID != "" {
+				workspaceID = cfg.WorkspaceID
+			}
+			return map[string]string{"id": cfg.ID, "workspace_id": workspaceID}
+		},
+		state: func(cfg client.Config) map[string]string {
+			return map[string]string{"slug": cfg.Slug}
+		},
+		displayName: func(cfg client.Config) string { return cfg.Name },
+		resource:    NewConfigResource,
+	})
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Kinds of objects a gateway config refers to.
+const (
+	configReferenceVirtualKey = client.ConfigReferenceVirtualKey
+	configReferenceProvider   = client.ConfigReferenceProvider
+	configReferenceGuardrail  = client.ConfigReferenceGuardrail
+)
+
+// configReference is a virtual key, provider or guardrail that a gateway
+// config refers to. location is where in the config JSON the reference
+// appears, such as targets[1].virtual_key.
+type configReference struct {
+	kind     string
+	slug     string
+	location string
+}
+
+// configReferenceKey identifies the object a reference names.
+type configReferenceKey struct{ kind, slug string }
+
+// configReferences returns the objects that the gateway config refers to, as
+// found by client.ConfigReferences.
+func configReferences(config map[string]interface{}) []configReference {
+	var refs []configReference
+	for _, ref := range client.ConfigReferences(config) {
+		refs = append(refs, configReference{ref.Kind, ref.Slug, ref.Location()})
+	}
+	return refs
+}
+
+// priorConfigReferences returns the objects the prior config JSON refers to.
+func priorConfigReferences(configJSON string) map[configReferenceKey]bool {
+	refs := map[configReferenceKey]bool{}
+	var config map[string]interface{}
+	if json.Unmarshal([]byte(configJSON), &config) == nil {
+		for _, ref := range configReferences(config) {
+			refs[configReferenceKey{ref.kind, ref.slug}] = true
+		}
+	}
+	return refs
+}
+
+// validateConfigReferences checks that every virtual key, provider and
+// guardrail the gateway config JSON refers to exists, adding an error on the
+// config attribute for each dangling reference. Virtual keys and providers
+// are looked up in workspaceID, and Model Catalog providers also as
+// integrations, which they may name; both are skipped when checkProviders is
+// false because the workspace is not known yet. Lookups that fail for
+// reasons other than a missing object only warn, so an API outage does not
+// block planning.
+//
+// At plan time, objects the prior config did not refer to may be created in
+// the same apply, so dangling references to them only warn; prior holds the
+// objects of the prior config. At apply time prior is nil and every dangling
+// reference is an error, since the objects the config depends on exist by
+// then.
+func validateConfigReferences(ctx context.Context, c *client.Client, configJSON, workspaceID string, checkProviders bool, prior map[configReferenceKey]bool, diags *diag.Diagnostics) {
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(configJSON), &config); err != nil {
+		// Create and Update report invalid JSON.
+		return
+	}
+
+	where := "the API key's workspace"
+	if workspaceID != "" {
+		where = "workspace " + workspaceID
+	}
+
+	// Look each object up once, however often it is referenced.
+	found := map[configReferenceKey]bool{}
+	for _, ref := range configReferences(config) {
+		if ref.kind != configReferenceGuardrail && !checkProviders {
+			continue
+		}
+		key := configReferenceKey{ref.kind, ref.slug}
+		exists, checked := found[key]
+		if !checked {
+			var err error
+			exists, err = configReferenceExists(ctx, c, ref, workspaceID)
+			if err != nil {
+				diags.AddAttributeWarning(
+					path.Root("config"),
+					"Unable to Validate Config Reference",
+					fmt.Sprintf("Could not look up %s %q referenced at %s: %s", ref.kind, ref.slug, ref.location, err),
+				)
+				exists = true
+			}
+			found[key] = exists
+		}
+		if exists {
+			continue
+		}
+
+		var detail string
+		switch ref.kind {
+		case configReferenceGuardrail:
+			detail = fmt.Sprintf("%s refers to guardrail %q, which does not exist.", ref.location, ref.slug)
+		case configReferenceVirtualKey:
+			detail = fmt.Sprintf("%s refers to virtual key %q, which does not exist in %s.", ref.location, ref.slug, where)
+		default:
+			detail = fmt.Sprintf("%s refers to provider %q, which does not exist in %s and is not an integration.", ref.location, ref.slug, where)
+		}
+		if prior != nil && !prior[key] {
+			diags.AddAttributeWarning(
+				path.Root("config"),
+				"Unresolved Config Reference",
+				detail+" If it is created in this apply, the reference is checked again before the config is saved; "+
+					"otherwise the apply fails.",
+			)
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root("config"),
+			"Dangling Config Reference",
+			detail+" Requests routed through this config would fail. Set validate_references = false to skip this check.",
+		)
+	}
+}
+
+// configReferenceExists reports whether the object ref refers to exists.
+func configReferenceExists(ctx context.Context, c *client.Client, ref configReference, workspaceID string) (bool, error) {
+	var err error
+	switch ref.kind {
+	case configReferenceGuardrail:
+		_, err = c.GetGuardrail(ctx, ref.slug)
+	case configReferenceVirtualKey:
+		_, err = c.GetProvider(ctx, ref.slug, workspaceID)
+	default:
+		_, err = c.GetProvider(ctx, ref.slug, workspaceID)
+		if client.IsNotFound(err) {
+			_, err = c.GetIntegration(ctx, ref.slug)
+		}
+	}
+	if client.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
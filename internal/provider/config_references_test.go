@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+func TestConfigReferences(t *testing.T) {
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(`{
+		"strategy": {"mode": "fallback"},
+		"input_guardrails": ["pii-check", {"default.contains": {"operator": "none", "words": ["secret"]}}],
+		"targets": [
+			{"virtual_key": "openai-vk", "output_guardrails": ["tone-check"]},
+			{
+				"strategy": {"mode": "loadbalance"},
+				"targets": [
+					{"provider": "@anthropic-prod", "after_request_hooks": [{"id": "json-check"}]},
+					{"provider": "openai", "api_key": "sk-inline", "override_params": {"model": "@azure-prod/gpt-4o"}}
+				]
+			}
+		]
+	}`), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []configReference{
+		{configReferenceGuardrail, "pii-check", "input_guardrails[0]"},
+		{configReferenceVirtualKey, "openai-vk", "targets[0].virtual_key"},
+		{configReferenceGuardrail, "tone-check", "targets[0].output_guardrails[0]"},
+		{configReferenceProvider, "anthropic-prod", "targets[1].targets[0].provider"},
+		{configReferenceGuardrail, "json-check", "targets[1].targets[0].after_request_hooks[0].id"},
+		{configReferenceProvider, "azure-prod", "targets[1].targets[1].override_params.model"},
+	}
+	if got := configReferences(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("configReferences() =\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestValidateConfigReferences(t *testing.T) {
+	ctx := context.Background()
+
+	existing := map[string]bool{
+		"/providers/openai-vk":      true,
+		"/providers/anthropic-prod": true,
+		"/integrations/azure-prod":  true,
+		"/guardrails/pii-check":     true,
+	}
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		switch {
+		case r.URL.Path == "/guardrails/flaky":
+			w.WriteHeader(http.StatusBadRequest)
+		case existing[r.URL.Path]:
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name           string
+		config         string
+		checkProviders bool
+		prior          map[configReferenceKey]bool
+		wantErrors     []string
+		wantWarnings   int
+	}{
+		{
+			name:           "all references exist",
+			config:         `{"input_guardrails":["pii-check"],"targets":[{"virtual_key":"openai-vk"},{"provider":"@anthropic-prod"},{"provider":"@azure-prod"}]}`,
+			checkProviders: true,
+		},
+		{
+			name:           "dangling references",
+			config:         `{"targets":[{"virtual_key":"azure-prod"},{"provider":"@missing"},{"input_guardrails":["gone","gone"]}]}`,
+			checkProviders: true,
+			wantErrors: []string{
+				`targets[0].virtual_key refers to virtual key "azure-prod", which does not exist in workspace ws-uuid-1.`,
+				`targets[1].provider refers to provider "missing", which does not exist in workspace ws-uuid-1 and is not an integration.`,
+				`targets[2].input_guardrails[0] refers to guardrail "gone", which does not exist.`,
+				`targets[2].input_guardrails[1] refers to guardrail "gone", which does not exist.`,
+			},
+		},
+		{
+			name:           "providers skipped without a known workspace",
+			config:         `{"targets":[{"virtual_key":"missing"},{"input_guardrails":["gone"]}]}`,
+			checkProviders: false,
+			wantErrors:     []string{`targets[1].input_guardrails[0] refers to guardrail "gone", which does not exist.`},
+		},
+		{
+			name:           "lookup failures only warn",
+			config:         `{"input_guardrails":["flaky"]}`,
+			checkProviders: true,
+			wantWarnings:   1,
+		},
+		{
+			name:           "created in the same apply",
+			config:         `{"targets":[{"virtual_key":"new-vk"},{"provider":"@new-provider"}],"input_guardrails":["new-guardrail"]}`,
+			checkProviders: true,
+			prior:          map[configReferenceKey]bool{},
+			wantWarnings:   3,
+		},
+		{
+			name:           "unchanged reference at plan time",
+			config:         `{"targets":[{"virtual_key":"gone-vk"},{"virtual_key":"new-vk"}]}`,
+			checkProviders: true,
+			prior:          priorConfigReferences(`{"virtual_key":"gone-vk"}`),
+			wantErrors:     []string{`targets[0].virtual_key refers to virtual key "gone-vk", which does not exist in workspace ws-uuid-1.`},
+			wantWarnings:   1,
+		},
+		{
+			name:           "invalid JSON is left to apply",
+			config:         `{"targets":`,
+			checkProviders: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateConfigReferences(ctx, c, tc.config, "ws-uuid-1", tc.checkProviders, tc.prior, &diags)
+
+			var gotErrors []string
+			for _, d := range diags.Errors() {
+				detail, _, _ := strings.Cut(d.Detail(), " Requests routed")
+				gotErrors = append(gotErrors, detail)
+			}
+			if !reflect.DeepEqual(gotErrors, tc.wantErrors) {
+				t.Errorf("errors =\n%q\nwant\n%q", gotErrors, tc.wantErrors)
+			}
+			if got := len(diags.Warnings()); got != tc.wantWarnings {
+				t.Errorf("got %d warnings, want %d: %v", got, tc.wantWarnings, diags.Warnings())
+			}
+		})
+	}
+
+	// Each object is looked up once however often it is referenced.
+	requests = nil
+	var diags diag.Diagnostics
+	validateConfigReferences(ctx, c, `{"input_guardrails":["pii-check"],"output_guardrails":["pii-check"]}`, "", true, nil, &diags)
+	if len(requests) != 1 {
+		t.Errorf("requests = %v, want a single lookup", requests)
+	}
+}
+
+// TestConfigResource_ModifyPlanReferencesCreatedInSameApply verifies that a
+// new config referring to a provider and guardrail created in the same apply
+// plans with warnings, and that the apply-time check then requires them.
+func TestConfigResource_ModifyPlanReferencesCreatedInSameApply(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	r := NewConfigResource().(*configResource)
+	r.client = c
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	const config = `{"targets":[{"virtual_key":"openai-new"}],"input_guardrails":["pii-new"]}`
+	vals := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		vals[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, "routing")
+	vals["config"] = tftypes.NewValue(tftypes.String, config)
+	vals["rollback_to_version_id"] = tftypes.NewValue(tftypes.String, nil)
+	vals["workspace_id"] = tftypes.NewValue(tftypes.String, "ws-uuid-1")
+	vals["is_default"] = tftypes.NewValue(tftypes.Bool, nil)
+	vals["deletion_protection"] = tftypes.NewValue(tftypes.Bool, false)
+	vals["validate_references"] = tftypes.NewValue(tftypes.Bool, true)
+	planned := tftypes.NewValue(typ, vals)
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: planned},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	var unresolved int
+	for _, d := range resp.Diagnostics.Warnings() {
+		if d.Summary() == "Unresolved Config Reference" {
+			unresolved++
+		}
+	}
+	if unresolved != 2 {
+		t.Errorf("got %d unresolved reference warnings, want 2: %v", unresolved, resp.Diagnostics)
+	}
+
+	// Had the objects not been created, Create fails before saving.
+	var diags diag.Diagnostics
+	validateConfigReferences(ctx, c, config, "ws-uuid-1", true, nil, &diags)
+	if len(diags.Errors()) != 2 {
+		t.Errorf("got %d apply-time errors, want 2: %v", len(diags.Errors()), diags)
+	}
+}
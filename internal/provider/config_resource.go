@@ -5,11 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
@@ -19,6 +23,9 @@ var (
 	_ resource.Resource                = &configResource{}
 	_ resource.ResourceWithConfigure   = &configResource{}
 	_ resource.ResourceWithImportState = &configResource{}
+	_ resource.ResourceWithMoveState   = &configResource{}
+	_ resource.ResourceWithModifyPlan  = &configResource{}
+	_ resource.ResourceWithIdentity    = &configResource{}
 )
 
 // NewConfigResource is a helper function to simplify the provider implementation.
@@ -33,16 +40,20 @@ type configResource struct {
 
 // configResourceModel maps the resource schema data.
 type configResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Slug        types.String `tfsdk:"slug"`
-	Name        types.String `tfsdk:"name"`
-	Config      types.String `tfsdk:"config"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	IsDefault   types.Bool   `tfsdk:"is_default"`
-	Status      types.String `tfsdk:"status"`
-	VersionID   types.String `tfsdk:"version_id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID                  types.String `tfsdk:"id"`
+	Slug                types.String `tfsdk:"slug"`
+	Name                types.String `tfsdk:"name"`
+	Config              types.String `tfsdk:"config"`
+	RollbackToVersionID types.String `tfsdk:"rollback_to_version_id"`
+	WorkspaceID         types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug       types.String `tfsdk:"workspace_slug"`
+	IsDefault           types.Bool   `tfsdk:"is_default"`
+	Status              types.String `tfsdk:"status"`
+	VersionID           types.String `tfsdk:"version_id"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
+	ValidateReferences  types.Bool   `tfsdk:"validate_references"`
 }
 
 // Metadata returns the resource type name.
@@ -74,8 +85,19 @@ func (r *configResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:    true,
 			},
 			"config": schema.StringAttribute{
-				Description: "JSON configuration object containing routing rules, cache settings, retry policies, etc.",
-				Required:    true,
+				Description: "JSON configuration object containing routing rules, cache settings, retry policies, etc. " +
+					"Exactly one of config or rollback_to_version_id must be set.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("rollback_to_version_id")),
+				},
+			},
+			"rollback_to_version_id": schema.StringAttribute{
+				Description: "Version ID of an earlier config version to restore (see the portkey_config_versions data source). " +
+					"The saved JSON of that version is planned as the new config and applied as a new version. " +
+					"Only valid on an existing config; replace it with config once the incident is over.",
+				Optional: true,
 			},
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID to create the config in. Required when using org-level API keys.",
@@ -83,9 +105,9 @@ func (r *configResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"is_default": schema.BoolAttribute{
 				Description: "Whether this config is the default for the workspace.",
 				Optional:    true,
@@ -107,6 +129,27 @@ func (r *configResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Timestamp when the config was last updated.",
 				Computed:    true,
 			},
+			"deletion_protection": deletionProtectionAttribute(),
+			"validate_references": schema.BoolAttribute{
+				Description: "When true, each plan checks that the virtual keys, Model Catalog providers (`@slug`) and guardrails " +
+					"referenced in config exist, in the config's workspace and at any target depth, and fails on dangling references " +
+					"instead of leaving them to fail at request time. New references only warn at plan time, since they may name " +
+					"objects created in the same apply, and are checked again before the config is saved. References that " +
+					"depend on values unknown at plan time are skipped. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *configResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id":           identityAttribute("Config identifier (UUID)."),
+			"workspace_id": optionalIdentityAttribute("Workspace identifier (UUID)."),
 		},
 	}
 }
@@ -131,6 +174,117 @@ func (r *configResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ModifyPlan resolves rollback_to_version_id to the JSON of that version so
+// the plan shows exactly which config will be restored, and checks the
+// references of the planned config when validate_references is set.
+func (r *configResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+	modifyDeletionProtectionPlan(ctx, req, resp, r.client, "Config")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan configResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RollbackToVersionID.IsNull() {
+		r.modifyRollbackPlan(ctx, req, resp, &plan)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.ValidateReferences.ValueBool() || plan.Config.IsUnknown() || plan.Config.IsNull() || r.client == nil {
+		return
+	}
+
+	// Without a configured workspace_id the API key's workspace is used. A
+	// configured workspace_id that is unknown, such as the ID of a workspace
+	// created in the same apply, leaves providers unchecked.
+	workspaceID := plan.WorkspaceID.ValueString()
+	checkProviders := !plan.WorkspaceID.IsUnknown()
+	if !checkProviders {
+		var configWorkspaceID types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("workspace_id"), &configWorkspaceID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		checkProviders = configWorkspaceID.IsNull()
+	}
+
+	// References the prior config already had must resolve now; new ones
+	// may name objects created in this apply and are checked again then.
+	prior := map[configReferenceKey]bool{}
+	if !req.State.Raw.IsNull() {
+		var state configResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prior = priorConfigReferences(state.Config.ValueString())
+	}
+
+	validateConfigReferences(ctx, r.client, plan.Config.ValueString(), workspaceID, checkProviders, prior, &resp.Diagnostics)
+}
+
+// modifyRollbackPlan plans the JSON of the version rollback_to_version_id
+// names as the new config.
+func (r *configResource) modifyRollbackPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan *configResourceModel) {
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rollback_to_version_id"),
+			"Rollback Requires an Existing Config",
+			"rollback_to_version_id can only be set on a config that already exists. Set config when creating a new config.",
+		)
+		return
+	}
+
+	if plan.RollbackToVersionID.IsUnknown() || r.client == nil {
+		return
+	}
+
+	var state configResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionID := plan.RollbackToVersionID.ValueString()
+	version, err := r.client.GetConfigVersion(ctx, state.Slug.ValueString(), versionID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rollback_to_version_id"),
+				"Config Version Not Found",
+				fmt.Sprintf("Config %s has no version %s. Use the portkey_config_versions data source to list available versions.", state.Slug.ValueString(), versionID),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Portkey Config Version",
+			"Could not read version "+versionID+" of config "+state.Slug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Keep the current formatting when the config already matches the version.
+	if !state.Config.IsNull() && jsonSemanticallyEqual(state.Config.ValueString(), version.ConfigRaw) {
+		plan.Config = state.Config
+	} else {
+		plan.Config = types.StringValue(version.ConfigRaw)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("config"), plan.Config)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *configResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -151,6 +305,15 @@ func (r *configResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Objects created in the same apply exist by now, so every reference
+	// must resolve before the config is saved.
+	if plan.ValidateReferences.ValueBool() {
+		validateConfigReferences(ctx, r.client, plan.Config.ValueString(), plan.WorkspaceID.ValueString(), true, nil, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Create new config
 	createReq := client.CreateConfigRequest{
 		Name:   plan.Name.ValueString(),
@@ -211,6 +374,8 @@ func (r *configResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -284,12 +449,23 @@ func (r *configResource) Read(ctx context.Context, req resource.ReadRequest, res
 		state.UpdatedAt = types.StringValue(config.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
 	}
 
+	// deletion_protection is provider-side only; fill in the default after import.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(defaultDeletionProtection(r.client))
+	}
+	// validate_references is provider-side only as well.
+	if state.ValidateReferences.IsNull() {
+		state.ValidateReferences = types.BoolValue(false)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -310,6 +486,19 @@ func (r *configResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// A rollback target that was unknown at plan time is resolved now.
+	if plan.Config.IsUnknown() && !plan.RollbackToVersionID.IsNull() {
+		version, err := r.client.GetConfigVersion(ctx, state.Slug.ValueString(), plan.RollbackToVersionID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Portkey Config Version",
+				"Could not read version "+plan.RollbackToVersionID.ValueString()+" of config "+state.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		plan.Config = types.StringValue(version.ConfigRaw)
+	}
+
 	// Parse the config JSON string to a map
 	var configMap map[string]interface{}
 	if err := json.Unmarshal([]byte(plan.Config.ValueString()), &configMap); err != nil {
@@ -320,6 +509,35 @@ func (r *configResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if plan.ValidateReferences.ValueBool() {
+		validateConfigReferences(ctx, r.client, plan.Config.ValueString(), plan.WorkspaceID.ValueString(), true, nil, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Every update saves a new config version, so when only provider-side
+	// attributes such as deletion_protection or the form of workspace_id
+	// changed, keep the API's values from state instead.
+	if !configAPIFieldsChanged(&plan, &state) {
+		plan.ID = state.ID
+		plan.Slug = state.Slug
+		plan.IsDefault = state.IsDefault
+		plan.Status = state.Status
+		plan.VersionID = state.VersionID
+		plan.CreatedAt = state.CreatedAt
+		plan.UpdatedAt = state.UpdatedAt
+
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+		setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+		return
+	}
+
 	// Update existing config
 	updateReq := client.UpdateConfigRequest{
 		Name:   plan.Name.ValueString(),
@@ -349,7 +567,7 @@ func (r *configResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.ID = types.StringValue(config.ID)
 	plan.Slug = types.StringValue(config.Slug)
 	// Preserve workspace_id from state (API returns UUID but user may have provided slug)
-	// workspace_id has RequiresReplace, so it shouldn't change during update
+	// workspace_id only changes in place between the ID and slug of one workspace
 	plan.IsDefault = types.BoolValue(config.IsDefault == 1)
 	plan.Status = types.StringValue(config.Status)
 	plan.VersionID = types.StringValue(config.VersionID)
@@ -363,6 +581,17 @@ func (r *configResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// configAPIFieldsChanged reports whether the plan changes any attribute the
+// API stores: name, config or is_default.
+func configAPIFieldsChanged(plan, state *configResourceModel) bool {
+	if !plan.Name.Equal(state.Name) || !jsonSemanticallyEqual(plan.Config.ValueString(), state.Config.ValueString()) {
+		return true
+	}
+	return !plan.IsDefault.IsUnknown() && !plan.IsDefault.Equal(state.IsDefault)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -375,6 +604,10 @@ func (r *configResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if !checkDeletionProtection(state.DeletionProtection, "Config", state.Slug.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
 	// Delete existing config
 	err := r.client.DeleteConfig(ctx, state.Slug.ValueString())
 	if err != nil {
@@ -386,8 +619,27 @@ func (r *configResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
-// ImportState imports the resource state.
+// ImportState imports the resource state by slug, or by identity.
 func (r *configResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by slug
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	if req.ID == "" {
+		importSlugFromIdentity(ctx, req, resp, "config", func(id, workspaceID string) (string, error) {
+			configs, err := r.client.ListConfigs(ctx, workspaceID)
+			for _, cfg := range configs {
+				if cfg.ID == id {
+					return cfg.Slug, nil
+				}
+			}
+			return "", err
+		})
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), req.ID)...)
+}
+
+// MoveState moves portkey_config state from another provider and restapi_object configs.
+func (r *configResource) MoveState(_ context.Context) []resource.StateMover {
+	return importStateMovers(r,
+		attributeSource("portkey_config", "%s", []string{"slug", "id"}),
+		restapiObjectSource("/configs", restapiObjectSlug),
+	)
 }
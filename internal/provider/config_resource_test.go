@@ -1,11 +1,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
 
 func TestAccConfigResource_basic(t *testing.T) {
@@ -134,6 +144,78 @@ func TestAccConfigResource_jsonWhitespace(t *testing.T) {
 	})
 }
 
+// TestAccConfigResource_deletionProtection verifies that the provider-level
+// deletion_protection default applies to configs that do not set it, and that
+// the resource attribute overrides it.
+func TestAccConfigResource_deletionProtection(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-protected")
+	workspaceID := getTestWorkspaceID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigResourceConfigProtected(rName, workspaceID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_config.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccConfigResourceConfigProtected(rName, workspaceID, ""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Config Is Protected"),
+			},
+			{
+				Config: testAccConfigResourceConfigProtected(rName, workspaceID, "deletion_protection = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_config.test", "deletion_protection", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccConfigResource_validateReferences(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	workspaceID := getTestWorkspaceID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigResourceConfigValidated(rName, workspaceID, `{"strategy":{"mode":"fallback"},"targets":[{"virtual_key":"tf-acc-missing-vk"}]}`),
+				ExpectError: regexp.MustCompile("Dangling Config Reference"),
+			},
+			{
+				Config:      testAccConfigResourceConfigValidated(rName, workspaceID, `{"input_guardrails":["tf-acc-missing-guardrail"]}`),
+				ExpectError: regexp.MustCompile("Dangling Config Reference"),
+			},
+			{
+				Config: testAccConfigResourceConfigValidated(rName, workspaceID, `{"retry":{"attempts":3}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_config.test", "validate_references", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigResourceConfigValidated(name, workspaceID, config string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_config" "test" {
+  name                = %[1]q
+  workspace_id        = %[2]q
+  config              = %[3]q
+  validate_references = true
+}
+`, name, workspaceID, config)
+}
+
 func testAccConfigResourceConfig(name, workspaceID, config string) string {
 	return fmt.Sprintf(`
 provider "portkey" {}
@@ -161,3 +243,93 @@ resource "portkey_config" "test" {
 }
 `, name, workspaceID)
 }
+
+func testAccConfigResourceConfigProtected(name, workspaceID, extra string) string {
+	return fmt.Sprintf(`
+provider "portkey" {
+  deletion_protection = true
+}
+
+resource "portkey_config" "test" {
+  name         = %[1]q
+  workspace_id = %[2]q
+  config       = jsonencode({ retry = { attempts = 3 } })
+  %[3]s
+}
+`, name, workspaceID, extra)
+}
+
+// TestConfigResource_UpdateProviderSideOnly verifies that an update changing
+// only provider-side attributes does not save a new config version.
+func TestConfigResource_UpdateProviderSideOnly(t *testing.T) {
+	ctx := context.Background()
+	var writes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes = append(writes, r.Method+" "+r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	r := NewConfigResource().(*configResource)
+	r.client = c
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	stateVals := map[string]tftypes.Value{
+		"id":                     tftypes.NewValue(tftypes.String, "cfg-1"),
+		"name":                   tftypes.NewValue(tftypes.String, "routing"),
+		"slug":                   tftypes.NewValue(tftypes.String, "pc-routing"),
+		"config":                 tftypes.NewValue(tftypes.String, `{"retry":{"attempts":3}}`),
+		"rollback_to_version_id": tftypes.NewValue(tftypes.String, nil),
+		"workspace_id":           tftypes.NewValue(tftypes.String, "ws-uuid-1"),
+		"workspace_slug":         tftypes.NewValue(tftypes.String, nil),
+		"is_default":             tftypes.NewValue(tftypes.Bool, false),
+		"status":                 tftypes.NewValue(tftypes.String, "active"),
+		"version_id":             tftypes.NewValue(tftypes.String, "v-1"),
+		"created_at":             tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+		"updated_at":             tftypes.NewValue(tftypes.String, "2025-01-02T00:00:00Z"),
+		"deletion_protection":    tftypes.NewValue(tftypes.Bool, false),
+		"validate_references":    tftypes.NewValue(tftypes.Bool, false),
+	}
+	planVals := map[string]tftypes.Value{}
+	for name, value := range stateVals {
+		planVals[name] = value
+	}
+	planVals["config"] = tftypes.NewValue(tftypes.String, "{\n  \"retry\": {\"attempts\": 3}\n}")
+	planVals["deletion_protection"] = tftypes.NewValue(tftypes.Bool, true)
+	planVals["status"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	planVals["version_id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	planVals["updated_at"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	req := fwresource.UpdateRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, planVals)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, planVals)},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, stateVals)},
+	}
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if len(writes) != 0 {
+		t.Errorf("got API writes %v, want none", writes)
+	}
+	var protected types.Bool
+	var versionID types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("version_id"), &versionID)...)
+	if !protected.ValueBool() {
+		t.Error("deletion_protection was not updated in state")
+	}
+	if versionID.ValueString() != "v-1" {
+		t.Errorf("version_id = %q, want v-1", versionID.ValueString())
+	}
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &configVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &configVersionsDataSource{}
+)
+
+// NewConfigVersionsDataSource is a helper function to simplify the provider implementation.
+func NewConfigVersionsDataSource() datasource.DataSource {
+	return &configVersionsDataSource{}
+}
+
+// configVersionsDataSource is the data source implementation.
+type configVersionsDataSource struct {
+	client *client.Client
+}
+
+// configVersionsDataSourceModel maps the data source schema data.
+type configVersionsDataSourceModel struct {
+	Slug             types.String             `tfsdk:"slug"`
+	CurrentVersionID types.String             `tfsdk:"current_version_id"`
+	Versions         []configVersionDataModel `tfsdk:"versions"`
+	Filter           []listFilterModel        `tfsdk:"filter"`
+}
+
+// configVersionDataModel maps a single config version.
+type configVersionDataModel struct {
+	VersionID types.String `tfsdk:"version_id"`
+	Config    types.String `tfsdk:"config"`
+	Status    types.String `tfsdk:"status"`
+	IsCurrent types.Bool   `tfsdk:"is_current"`
+	CreatedBy types.String `tfsdk:"created_by"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *configVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_versions"
+}
+
+// Schema defines the schema for the data source.
+func (d *configVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the saved versions of a Portkey config, newest first. Use a version_id with rollback_to_version_id on portkey_config to revert a change.",
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				Description: "The slug of the config.",
+				Required:    true,
+			},
+			"current_version_id": schema.StringAttribute{
+				Description: "Version ID currently in use by the config.",
+				Computed:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "List of config versions, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version_id": schema.StringAttribute{
+							Description: "Version identifier (UUID).",
+							Computed:    true,
+						},
+						"config": schema.StringAttribute{
+							Description: "JSON configuration saved in this version.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the version.",
+							Computed:    true,
+						},
+						"is_current": schema.BoolAttribute{
+							Description: "Whether this version is currently in use.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "User who created the version, if reported by the API.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the version was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("versions"),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *configVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *configVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state configVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := d.client.ListConfigVersions(ctx, state.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Portkey Config Versions",
+			err.Error(),
+		)
+		return
+	}
+
+	config, err := d.client.GetConfig(ctx, state.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Portkey Config",
+			err.Error(),
+		)
+		return
+	}
+
+	state.CurrentVersionID = types.StringValue(config.VersionID)
+	state.Versions = make([]configVersionDataModel, 0, len(versions))
+	for _, v := range versions {
+		item := configVersionDataModel{
+			VersionID: types.StringValue(v.VersionID),
+			Config:    types.StringValue(v.ConfigRaw),
+			IsCurrent: types.BoolValue(v.VersionID == config.VersionID),
+		}
+
+		if v.Status != "" {
+			item.Status = types.StringValue(v.Status)
+		} else {
+			item.Status = types.StringNull()
+		}
+
+		if v.CreatedBy != "" {
+			item.CreatedBy = types.StringValue(v.CreatedBy)
+		} else {
+			item.CreatedBy = types.StringNull()
+		}
+
+		if !v.CreatedAt.IsZero() {
+			item.CreatedAt = types.StringValue(v.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+		} else {
+			item.CreatedAt = types.StringNull()
+		}
+
+		state.Versions = append(state.Versions, item)
+	}
+
+	state.Versions = applyListFilters(state.Versions, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigVersionsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-versions")
+	workspaceID := getTestWorkspaceID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigResourceConfig(rName, workspaceID, `{"retry":{"attempts":3}}`),
+			},
+			// After an update the previous version is still listed
+			{
+				Config: testAccConfigVersionsDataSourceConfig(rName, workspaceID, `{"retry":{"attempts":5}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.portkey_config_versions.test", "versions.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.portkey_config_versions.test", "current_version_id",
+						"portkey_config.test", "version_id",
+					),
+					resource.TestCheckResourceAttr("data.portkey_config_versions.test", "versions.0.is_current", "true"),
+					resource.TestCheckResourceAttr("data.portkey_config_versions.test", "versions.1.is_current", "false"),
+					resource.TestCheckResourceAttr("data.portkey_config_versions.test", "versions.1.config", `{"retry":{"attempts":3}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConfigResource_rollbackRequiresExistingConfig(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-rollback")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_config" "test" {
+  name                   = %[1]q
+  workspace_id           = %[2]q
+  rollback_to_version_id = "00000000-0000-0000-0000-000000000000"
+}
+`, rName, getTestWorkspaceID()),
+				ExpectError: regexp.MustCompile(`Rollback Requires an Existing Config`),
+			},
+		},
+	})
+}
+
+func testAccConfigVersionsDataSourceConfig(name, workspaceID, config string) string {
+	return testAccConfigResourceConfig(name, workspaceID, config) + `
+data "portkey_config_versions" "test" {
+  slug = portkey_config.test.slug
+}
+`
+}
@@ -30,6 +30,9 @@ type configsDataSource struct {
 type configsDataSourceModel struct {
 	WorkspaceID types.String         `tfsdk:"workspace_id"`
 	Configs     []configSummaryModel `tfsdk:"configs"`
+	Filter      []listFilterModel    `tfsdk:"filter"`
+	IDs         types.List           `tfsdk:"ids"`
+	Slugs       types.List           `tfsdk:"slugs"`
 }
 
 // configSummaryModel maps config summary data.
@@ -98,6 +101,11 @@ func (d *configsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 				},
 			},
+			"ids":   listIDsAttribute("configs"),
+			"slugs": listSlugsAttribute("configs"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("configs"),
 		},
 	}
 }
@@ -163,6 +171,13 @@ func (d *configsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		state.Configs = append(state.Configs, configState)
 	}
 
+	state.Configs = applyListFilters(state.Configs, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Configs, "id")
+	state.Slugs = listItemStrings(state.Configs, "slug")
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
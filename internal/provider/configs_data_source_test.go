@@ -44,6 +44,27 @@ func TestAccConfigsDataSource_withWorkspace(t *testing.T) {
 	})
 }
 
+func TestAccConfigsDataSource_filter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-ds-filter")
+	workspaceID := getTestWorkspaceID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigsDataSourceConfigWithFilter(rName, workspaceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.portkey_configs.filtered", "configs.#", "1"),
+					resource.TestCheckResourceAttr("data.portkey_configs.filtered", "configs.0.name", rName),
+					resource.TestCheckResourceAttrPair("data.portkey_configs.filtered", "ids.0", "portkey_config.test", "id"),
+					resource.TestCheckResourceAttrPair("data.portkey_configs.filtered", "slugs.0", "portkey_config.test", "slug"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConfigsDataSourceConfig() string {
 	return `
 provider "portkey" {}
@@ -70,3 +91,33 @@ data "portkey_configs" "workspace" {
 }
 `, name, workspaceID)
 }
+
+func testAccConfigsDataSourceConfigWithFilter(name, workspaceID string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_config" "test" {
+  name         = %[1]q
+  workspace_id = %[2]q
+  config       = jsonencode({
+    retry = { attempts = 3 }
+  })
+}
+
+data "portkey_configs" "filtered" {
+  workspace_id = %[2]q
+
+  filter {
+    name   = "name"
+    values = ["%[1]s*"]
+  }
+
+  filter {
+    name   = "status"
+    values = ["active"]
+  }
+
+  depends_on = [portkey_config.test]
+}
+`, name, workspaceID)
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &defaultVersionResource{}
+	_ resource.ResourceWithConfigure   = &defaultVersionResource{}
+	_ resource.ResourceWithImportState = &defaultVersionResource{}
+	_ resource.ResourceWithIdentity    = &defaultVersionResource{}
+)
+
+// defaultVersionResourceDefinition describes a resource that pins the
+// default (live) version of a versioned object, such as a prompt.
+type defaultVersionResourceDefinition struct {
+	// typeName is the resource type name without the provider prefix, e.g.
+	// "prompt_default_version".
+	typeName string
+	// kind is the noun of the versioned object, e.g. "prompt partial", and
+	// title its title-cased form used in diagnostics.
+	kind, title string
+	// objectResource is the managed resource type of the versioned object and
+	// edited the attribute whose edits create new versions, both used in the
+	// schema description.
+	objectResource, edited string
+	// slugAttribute names the slug argument, e.g. "prompt_slug", and
+	// versionIDAttribute the computed version UUID, e.g. "prompt_version_id".
+	slugAttribute, versionIDAttribute string
+
+	// makeDefault makes version the default version of the object.
+	makeDefault func(ctx context.Context, c *client.Client, slug string, version int) error
+	// getVersion returns the number and UUID of a version of the object, or
+	// of its default version when version is empty.
+	getVersion func(ctx context.Context, c *client.Client, slug, version string) (int, string, error)
+}
+
+// defaultVersionResource implements resource.Resource from a definition.
+type defaultVersionResource struct {
+	def    defaultVersionResourceDefinition
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *defaultVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.def.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *defaultVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Pins which version of a Portkey ` + r.def.kind + ` is the default (live) version.
+
+Use this together with ` + "`make_default = false`" + ` on ` + "`" + r.def.objectResource + "`" + ` so that ` + r.def.edited + ` edits create new
+versions without promoting them; rolling forward or back is then a one-number change to ` + "`version`" + `.
+
+Destroying this resource leaves the current default version in place.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this resource (the " + r.def.kind + " slug).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			r.def.slugAttribute: schema.StringAttribute{
+				Description: "Slug or ID of the " + r.def.kind + ".",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version number to make the default.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			r.def.versionIDAttribute: schema.StringAttribute{
+				Description: "Version identifier (UUID) of the default version.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *defaultVersionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			r.def.slugAttribute: identityAttribute(strings.ToUpper(r.def.kind[:1]) + r.def.kind[1:] + " slug."),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *defaultVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create makes the configured version the default and sets the initial Terraform state.
+func (r *defaultVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var slug types.String
+	var version types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(r.def.slugAttribute), &slug)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.makeDefault(ctx, slug.ValueString(), int(version.ValueInt64()), &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *defaultVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var slug types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(r.def.slugAttribute), &slug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetching the object without a version returns the default version.
+	version, versionID, err := r.def.getVersion(ctx, r.client, slug.ValueString(), "")
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Portkey "+r.def.title+" Default Version",
+			"Could not read Portkey "+r.def.kind+" "+slug.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	r.setState(ctx, &resp.State, slug.ValueString(), version, versionID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Update makes the newly configured version the default.
+func (r *defaultVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var slug types.String
+	var version types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(r.def.slugAttribute), &slug)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.makeDefault(ctx, slug.ValueString(), int(version.ValueInt64()), &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Delete removes the resource from Terraform state. The object always has a
+// default version, so the current default is left in place.
+func (r *defaultVersionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the resource state.
+// Import format: "<slug>". The default version is always read from the API.
+func (r *defaultVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	slug := importIDFromIdentity(ctx, req, &resp.Diagnostics, r.def.slugAttribute)
+	if slug == "" || strings.Contains(slug, "@") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be the %s; the default version is read from the API. Got: %q", r.def.slugAttribute, slug),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), slug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.def.slugAttribute), slug)...)
+}
+
+// makeDefault promotes version and records it, with its version ID, in state.
+func (r *defaultVersionResource) makeDefault(ctx context.Context, slug string, version int, state *tfsdk.State, diags *diag.Diagnostics) {
+	if err := r.def.makeDefault(ctx, r.client, slug, version); err != nil {
+		diags.AddError(
+			"Error Setting Portkey "+r.def.title+" Default Version",
+			fmt.Sprintf("Could not make version %d of %s %s the default: %s", version, r.def.kind, slug, err.Error()),
+		)
+		return
+	}
+
+	_, versionID, err := r.def.getVersion(ctx, r.client, slug, strconv.Itoa(version))
+	if err != nil {
+		diags.AddError(
+			"Error Reading Portkey "+r.def.title+" Version",
+			fmt.Sprintf("Could not read version %d of %s %s: %s", version, r.def.kind, slug, err.Error()),
+		)
+		return
+	}
+	r.setState(ctx, state, slug, version, versionID, diags)
+}
+
+// setState writes all attributes of the resource to state.
+func (r *defaultVersionResource) setState(ctx context.Context, state *tfsdk.State, slug string, version int, versionID string, diags *diag.Diagnostics) {
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), slug)...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.def.slugAttribute), slug)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("version"), int64(version))...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.def.versionIDAttribute), versionID)...)
+}
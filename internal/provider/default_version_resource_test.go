@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestDefaultVersionResource_CreateRead promotes a prompt partial version and
+// reads the default version back through the shared implementation.
+func TestDefaultVersionResource_CreateRead(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/prompts/partials/my-partial/makeDefault":
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/prompts/partials/my-partial":
+			_, _ = w.Write([]byte(`{"id":"pp-1","slug":"my-partial","version":2,"prompt_partial_version_id":"ppv-2"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("NewProtocol6WithError: %v", err)
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: objectValue(t, schemas.Provider.ValueType(), map[string]tftypes.Value{
+			"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+			"base_url": tftypes.NewValue(tftypes.String, srv.URL),
+		}),
+	})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider: %v %v", err, configureResp.Diagnostics)
+	}
+
+	const typeName = "portkey_prompt_partial_default_version"
+	typ := schemas.ResourceSchemas[typeName].ValueType()
+	config := map[string]tftypes.Value{
+		"prompt_partial_slug": tftypes.NewValue(tftypes.String, "my-partial"),
+		"version":             tftypes.NewValue(tftypes.Number, 2),
+	}
+	planned := map[string]tftypes.Value{
+		"id":                        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"prompt_partial_slug":       config["prompt_partial_slug"],
+		"version":                   config["version"],
+		"prompt_partial_version_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}
+	applyResp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     typeName,
+		PriorState:   objectValue(t, typ, nil),
+		PlannedState: objectValue(t, typ, planned),
+		Config:       objectValue(t, typ, config),
+	})
+	if err != nil || len(applyResp.Diagnostics) > 0 {
+		t.Fatalf("ApplyResourceChange: %v %v", err, applyResp.Diagnostics)
+	}
+
+	readResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: applyResp.NewState,
+	})
+	if err != nil || len(readResp.Diagnostics) > 0 {
+		t.Fatalf("ReadResource: %v %v", err, readResp.Diagnostics)
+	}
+
+	for name, state := range map[string]*tfprotov6.DynamicValue{"created": applyResp.NewState, "read": readResp.NewState} {
+		value, err := state.Unmarshal(typ)
+		if err != nil {
+			t.Fatalf("Unmarshal %s state: %v", name, err)
+		}
+		var attrs map[string]tftypes.Value
+		if err := value.As(&attrs); err != nil {
+			t.Fatalf("%s state.As: %v", name, err)
+		}
+		var id, versionID string
+		_ = attrs["id"].As(&id)
+		_ = attrs["prompt_partial_version_id"].As(&versionID)
+		if id != "my-partial" || versionID != "ppv-2" {
+			t.Errorf("%s state: id = %q, prompt_partial_version_id = %q", name, id, versionID)
+		}
+	}
+
+	want := []string{
+		"PUT /prompts/partials/my-partial/makeDefault",
+		"GET /prompts/partials/my-partial?version=2",
+		"GET /prompts/partials/my-partial",
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("requests = %q, want %q", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, requests[i], want[i])
+		}
+	}
+}
+
+// TestDefaultVersionResource_ImportState imports by slug and rejects a
+// version suffix, since the default version is always read from the API.
+func TestDefaultVersionResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("NewProtocol6WithError: %v", err)
+	}
+
+	const typeName = "portkey_prompt_default_version"
+	resp, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{TypeName: typeName, ID: "my-prompt"})
+	if err != nil || len(resp.Diagnostics) > 0 || len(resp.ImportedResources) != 1 {
+		t.Fatalf("ImportResourceState: %v %v", err, resp.Diagnostics)
+	}
+
+	resp, err = server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{TypeName: typeName, ID: "my-prompt@3"})
+	if err != nil {
+		t.Fatalf("ImportResourceState: %v", err)
+	}
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary != "Invalid Import ID" {
+		t.Errorf("expected an Invalid Import ID error, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// deletionProtectionAttribute returns the deletion_protection attribute shared
+// by resources whose destruction breaks live traffic.
+func deletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "When true, destroying or replacing this resource fails. Set it to false and apply before " +
+			"destroying. Defaults to the provider's `deletion_protection` setting, which is false unless configured.",
+		Optional: true,
+		Computed: true,
+	}
+}
+
+// defaultDeletionProtection returns the provider-level deletion_protection
+// default carried by c, or false before the provider is configured.
+func defaultDeletionProtection(c *client.Client) bool {
+	return c != nil && c.DeletionProtection
+}
+
+// modifyDeletionProtectionPlan resolves deletion_protection to the provider
+// default when it is not configured, and warns when a destroy or replacement
+// of a protected resource is planned. kind is the title-cased resource name
+// used in diagnostics, e.g. "Integration". Call it first in ModifyPlan, before
+// any early return on destroy.
+func modifyDeletionProtectionPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, c *client.Client, kind string) {
+	attr := path.Root("deletion_protection")
+
+	if !req.Plan.Raw.IsNull() {
+		var configured types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attr, &configured)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if configured.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attr, types.BoolValue(defaultDeletionProtection(c)))...)
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var protected types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, attr, &protected)...)
+	if resp.Diagnostics.HasError() || !protected.ValueBool() {
+		return
+	}
+
+	switch {
+	case req.Plan.Raw.IsNull():
+		resp.Diagnostics.AddWarning(
+			"Protected "+kind+" Will Not Be Destroyed",
+			"This resource has deletion_protection enabled, so applying this destroy will fail. "+
+				"Set deletion_protection = false and apply first.",
+		)
+	case len(resp.RequiresReplace) > 0:
+		resp.Diagnostics.AddWarning(
+			"Protected "+kind+" Cannot Be Replaced",
+			"This change requires replacing the resource, but it has deletion_protection enabled, so the apply will fail. "+
+				"Set deletion_protection = false and apply first.",
+		)
+	}
+}
+
+// checkDeletionProtection adds an error and returns false when protected is
+// true. Delete implementations call it before deleting anything.
+func checkDeletionProtection(protected types.Bool, kind, id string, diags *diag.Diagnostics) bool {
+	if !protected.ValueBool() {
+		return true
+	}
+	diags.AddError(
+		kind+" Is Protected",
+		kind+" "+id+" has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.",
+	)
+	return false
+}
+
+// planChangesAttributes reports whether the planned update changes any of the
+// named top-level attributes, counting values unknown in the plan as changes.
+// Update implementations call it with the attributes they send to the API, so
+// that changing only provider-side attributes such as deletion_protection
+// does not issue an API update.
+func planChangesAttributes(plan tfsdk.Plan, state tfsdk.State, names ...string) bool {
+	for _, name := range names {
+		attrPath := tftypes.NewAttributePath().WithAttributeName(name)
+		planned, _, planErr := tftypes.WalkAttributePath(plan.Raw, attrPath)
+		prior, _, stateErr := tftypes.WalkAttributePath(state.Raw, attrPath)
+		if planErr != nil || stateErr != nil {
+			return true
+		}
+		plannedValue, _ := planned.(tftypes.Value)
+		priorValue, _ := prior.(tftypes.Value)
+		if !plannedValue.IsFullyKnown() || !plannedValue.Equal(priorValue) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		protected types.Bool
+		want      bool
+	}{
+		{"null", types.BoolNull(), true},
+		{"false", types.BoolValue(false), true},
+		{"true", types.BoolValue(true), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			if got := checkDeletionProtection(tc.protected, "Config", "prod-routing", &diags); got != tc.want {
+				t.Fatalf("checkDeletionProtection() = %v, want %v", got, tc.want)
+			}
+			if diags.HasError() == tc.want {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !tc.want {
+				d := diags.Errors()[0]
+				if d.Summary() != "Config Is Protected" || !strings.Contains(d.Detail(), "prod-routing") {
+					t.Errorf("unexpected error: %s: %s", d.Summary(), d.Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultDeletionProtection(t *testing.T) {
+	if defaultDeletionProtection(nil) {
+		t.Error("expected false for an unconfigured provider")
+	}
+	if defaultDeletionProtection(&client.Client{}) {
+		t.Error("expected false by default")
+	}
+	if !defaultDeletionProtection(&client.Client{DeletionProtection: true}) {
+		t.Error("expected the provider default to be used")
+	}
+}
+
+func TestPlanChangesAttributes(t *testing.T) {
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":                tftypes.String,
+		"description":         tftypes.String,
+		"deletion_protection": tftypes.Bool,
+	}}
+	object := func(name, description interface{}, protected bool) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name":                tftypes.NewValue(tftypes.String, name),
+			"description":         tftypes.NewValue(tftypes.String, description),
+			"deletion_protection": tftypes.NewValue(tftypes.Bool, protected),
+		})
+	}
+	state := tfsdk.State{Raw: object("prod", nil, false)}
+
+	for _, tc := range []struct {
+		name    string
+		planned tftypes.Value
+		want    bool
+	}{
+		{"only deletion_protection", object("prod", nil, true), false},
+		{"name changed", object("staging", nil, true), true},
+		{"description set", object("prod", "Production", false), true},
+		{"description unknown", object("prod", tftypes.UnknownValue, false), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := planChangesAttributes(tfsdk.Plan{Raw: tc.planned}, state, "name", "description"); got != tc.want {
+				t.Errorf("planChangesAttributes() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
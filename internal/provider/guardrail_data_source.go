@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
@@ -52,19 +55,26 @@ func (d *guardrailDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 		Description: "Use this data source to get information about a Portkey guardrail.",
 		Attributes: map[string]schema.Attribute{
 			"slug": schema.StringAttribute{
-				Description: "The slug of the guardrail to look up.",
-				Required:    true,
+				Description: "The slug of the guardrail to look up. Exactly one of slug or name must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"id": schema.StringAttribute{
 				Description: "Guardrail identifier (UUID).",
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Human-readable name for the guardrail.",
-				Computed:    true,
+				Description: "Human-readable name for the guardrail. Set it instead of slug to look the guardrail up by name; " +
+					"exactly one guardrail (in workspace_id, when set) must have this name.",
+				Optional: true,
+				Computed: true,
 			},
 			"workspace_id": schema.StringAttribute{
-				Description: "Workspace ID the guardrail belongs to.",
+				Description: "Workspace ID the guardrail belongs to. When looking up by name, only this workspace is searched.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"checks": schema.StringAttribute{
@@ -123,7 +133,32 @@ func (d *guardrailDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	guardrail, err := d.client.GetGuardrail(ctx, state.Slug.ValueString())
+	slug := state.Slug.ValueString()
+	if !state.Name.IsNull() {
+		guardrails, err := d.client.ListGuardrails(ctx, state.WorkspaceID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Portkey Guardrails",
+				err.Error(),
+			)
+			return
+		}
+
+		name := state.Name.ValueString()
+		match, err := findOneByName(guardrails, "guardrail", name, func(v client.Guardrail) bool {
+			return v.Name == name
+		}, func(v client.Guardrail) string { return v.Slug })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Find Portkey Guardrail",
+				err.Error(),
+			)
+			return
+		}
+		slug = match.Slug
+	}
+
+	guardrail, err := d.client.GetGuardrail(ctx, slug)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Portkey Guardrail",
@@ -136,7 +171,10 @@ func (d *guardrailDataSource) Read(ctx context.Context, req datasource.ReadReque
 	state.ID = types.StringValue(guardrail.ID)
 	state.Slug = types.StringValue(guardrail.Slug)
 	state.Name = types.StringValue(guardrail.Name)
-	state.WorkspaceID = types.StringValue(guardrail.WorkspaceID)
+	// Keep a configured workspace_id as-is; it may be a slug.
+	if state.WorkspaceID.IsNull() {
+		state.WorkspaceID = types.StringValue(guardrail.WorkspaceID)
+	}
 	state.Status = types.StringValue(guardrail.Status)
 	state.VersionID = types.StringValue(guardrail.VersionID)
 
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewGuardrailListResource lists guardrails for `terraform query`.
+func NewGuardrailListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.Guardrail]{
+		typeName:       "guardrail",
+		kind:           "guardrails",
+		title:          "Guardrails",
+		workspaceScope: listWorkspaceOptional,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.Guardrail, error) {
+			return c.ListGuardrails(ctx, workspaceID)
+		},
+		fields: map[string]listResourceField[client.Guardrail]{
+			"name":   {"name", func(g client.Guardrail) string { return g.Name }},
+			"slug":   {"slug", func(g client.Guardrail) string { return g.Slug }},
+			"status": {"status", func(g client.Guardrail) string { return g.Status }},
+		},
+		identity: func(g client.Guardrail, workspaceID string) map[string]string {
+			if g.WorkspaceID != "" {
+				workspaceID = g.WorkspaceID
+			}
+			return map[string]string{"id": g.ID, "workspace_id": workspaceID}
+		},
+		state: func(g client.Guardrail) map[string]string {
+			return map[string]string{"slug": g.Slug}
+		},
+		displayName: func(g client.Guardrail) string { return g.Name },
+		resource:    NewGuardrailResource,
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +20,8 @@ var (
 	_ resource.Resource                = &guardrailResource{}
 	_ resource.ResourceWithConfigure   = &guardrailResource{}
 	_ resource.ResourceWithImportState = &guardrailResource{}
+	_ resource.ResourceWithModifyPlan  = &guardrailResource{}
+	_ resource.ResourceWithIdentity    = &guardrailResource{}
 )
 
 // NewGuardrailResource is a helper function to simplify the provider implementation.
@@ -33,16 +36,17 @@ type guardrailResource struct {
 
 // guardrailResourceModel maps the resource schema data.
 type guardrailResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Slug        types.String `tfsdk:"slug"`
-	Name        types.String `tfsdk:"name"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	Checks      types.String `tfsdk:"checks"`
-	Actions     types.String `tfsdk:"actions"`
-	Status      types.String `tfsdk:"status"`
-	VersionID   types.String `tfsdk:"version_id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID            types.String `tfsdk:"id"`
+	Slug          types.String `tfsdk:"slug"`
+	Name          types.String `tfsdk:"name"`
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug types.String `tfsdk:"workspace_slug"`
+	Checks        types.String `tfsdk:"checks"`
+	Actions       types.String `tfsdk:"actions"`
+	Status        types.String `tfsdk:"status"`
+	VersionID     types.String `tfsdk:"version_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
 }
 
 // Metadata returns the resource type name.
@@ -76,10 +80,8 @@ func (r *guardrailResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID to create the guardrail in.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"checks": schema.StringAttribute{
 				Description: "JSON array of guardrail checks. Each check has an 'id' and optional 'parameters'.",
 				Required:    true,
@@ -108,6 +110,16 @@ func (r *guardrailResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *guardrailResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id":           identityAttribute("Guardrail identifier (UUID)."),
+			"workspace_id": optionalIdentityAttribute("Workspace identifier (UUID)."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *guardrailResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -128,6 +140,12 @@ func (r *guardrailResource) Configure(_ context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+// ModifyPlan replaces the guardrail only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *guardrailResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *guardrailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -202,6 +220,8 @@ func (r *guardrailResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -240,6 +260,8 @@ func (r *guardrailResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -317,6 +339,8 @@ func (r *guardrailResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -340,10 +364,22 @@ func (r *guardrailResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
-// ImportState imports the resource state.
+// ImportState imports the resource state by slug, or by identity.
 func (r *guardrailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by slug
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	if req.ID == "" {
+		importSlugFromIdentity(ctx, req, resp, "guardrail", func(id, _ string) (string, error) {
+			guardrail, err := r.client.GetGuardrail(ctx, id)
+			if err != nil {
+				if client.IsNotFound(err) {
+					return "", nil
+				}
+				return "", err
+			}
+			return guardrail.Slug, nil
+		})
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), req.ID)...)
 }
 
 // mapGuardrailToState maps a Guardrail API response to the Terraform state model
@@ -383,22 +419,9 @@ func (r *guardrailResource) mapGuardrailToState(state *guardrailResourceModel, g
 
 // preserveJSONFormatting keeps user's JSON format if semantically equal
 func (r *guardrailResource) preserveJSONFormatting(oldJSON, newJSON string) types.String {
-	if oldJSON == "" {
-		return types.StringValue(newJSON)
-	}
-
-	var oldVal, newVal interface{}
-	oldErr := json.Unmarshal([]byte(oldJSON), &oldVal)
-	newErr := json.Unmarshal([]byte(newJSON), &newVal)
-
-	if oldErr == nil && newErr == nil {
-		oldBytes, _ := json.Marshal(oldVal)
-		newBytes, _ := json.Marshal(newVal)
-		if string(oldBytes) == string(newBytes) {
-			return types.StringValue(oldJSON)
-		}
+	if oldJSON != "" && jsonSemanticallyEqual(oldJSON, newJSON) {
+		return types.StringValue(oldJSON)
 	}
-
 	return types.StringValue(newJSON)
 }
 
@@ -30,6 +30,9 @@ type guardrailsDataSource struct {
 type guardrailsDataSourceModel struct {
 	WorkspaceID types.String            `tfsdk:"workspace_id"`
 	Guardrails  []guardrailSummaryModel `tfsdk:"guardrails"`
+	Filter      []listFilterModel       `tfsdk:"filter"`
+	IDs         types.List              `tfsdk:"ids"`
+	Slugs       types.List              `tfsdk:"slugs"`
 }
 
 // guardrailSummaryModel maps guardrail summary data.
@@ -93,6 +96,11 @@ func (d *guardrailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 					},
 				},
 			},
+			"ids":   listIDsAttribute("guardrails"),
+			"slugs": listSlugsAttribute("guardrails"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("guardrails"),
 		},
 	}
 }
@@ -152,6 +160,13 @@ func (d *guardrailsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		state.Guardrails = append(state.Guardrails, guardrailState)
 	}
 
+	state.Guardrails = applyListFilters(state.Guardrails, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Guardrails, "id")
+	state.Slugs = listItemStrings(state.Guardrails, "slug")
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
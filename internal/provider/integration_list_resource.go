@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewIntegrationListResource lists integrations for `terraform query`.
+func NewIntegrationListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.Integration]{
+		typeName:       "integration",
+		kind:           "integrations",
+		title:          "Integrations",
+		workspaceScope: listWorkspaceOptional,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.Integration, error) {
+			integrations, err := c.ListIntegrations(ctx)
+			if err != nil || workspaceID == "" {
+				return integrations, err
+			}
+			// The API lists every integration; keep those of the workspace.
+			var scoped []client.Integration
+			for _, i := range integrations {
+				if i.WorkspaceID != "" && sameWorkspace(ctx, c, i.WorkspaceID, workspaceID) {
+					scoped = append(scoped, i)
+				}
+			}
+			return scoped, nil
+		},
+		fields: map[string]listResourceField[client.Integration]{
+			"name":           {"name", func(i client.Integration) string { return i.Name }},
+			"slug":           {"slug", func(i client.Integration) string { return i.Slug }},
+			"ai_provider_id": {"AI provider (e.g. `openai`)", func(i client.Integration) string { return i.AIProviderID }},
+			"status":         {"status", func(i client.Integration) string { return i.Status }},
+		},
+		identity: func(i client.Integration, _ string) map[string]string {
+			return map[string]string{"id": i.ID, "workspace_id": i.WorkspaceID}
+		},
+		state: func(i client.Integration) map[string]string {
+			return map[string]string{"slug": i.Slug}
+		},
+		displayName: func(i client.Integration) string { return i.Name },
+		resource:    NewIntegrationResource,
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -26,6 +27,7 @@ var (
 	_ resource.Resource                = &integrationModelAccessResource{}
 	_ resource.ResourceWithConfigure   = &integrationModelAccessResource{}
 	_ resource.ResourceWithImportState = &integrationModelAccessResource{}
+	_ resource.ResourceWithIdentity    = &integrationModelAccessResource{}
 )
 
 // Type definitions for nested attributes
@@ -169,6 +171,16 @@ func (r *integrationModelAccessResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *integrationModelAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"integration_id": identityAttribute("Integration slug or ID."),
+			"model_slug":     identityAttribute("Model slug."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *integrationModelAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -239,6 +251,7 @@ func (r *integrationModelAccessResource) Create(ctx context.Context, req resourc
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -276,6 +289,7 @@ func (r *integrationModelAccessResource) Read(ctx context.Context, req resource.
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -324,6 +338,7 @@ func (r *integrationModelAccessResource) Update(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -388,8 +403,10 @@ func (r *integrationModelAccessResource) Delete(ctx context.Context, req resourc
 
 // ImportState imports the resource state.
 func (r *integrationModelAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics, "integration_id", "model_slug")
+
 	// Import ID should be in format: integration_id/model_slug
-	parts := strings.Split(req.ID, "/")
+	parts := strings.Split(id, "/")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid import ID",
@@ -400,7 +417,7 @@ func (r *integrationModelAccessResource) ImportState(ctx context.Context, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model_slug"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // Helper functions
@@ -29,10 +29,12 @@ type integrationModelsDataSource struct {
 
 // integrationModelsDataSourceModel maps the data source schema data.
 type integrationModelsDataSourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	IntegrationID  types.String `tfsdk:"integration_id"`
-	AllowAllModels types.Bool   `tfsdk:"allow_all_models"`
-	Models         types.List   `tfsdk:"models"`
+	ID             types.String      `tfsdk:"id"`
+	IntegrationID  types.String      `tfsdk:"integration_id"`
+	AllowAllModels types.Bool        `tfsdk:"allow_all_models"`
+	Models         types.List        `tfsdk:"models"`
+	Filter         []listFilterModel `tfsdk:"filter"`
+	Slugs          types.List        `tfsdk:"slugs"`
 }
 
 // Metadata returns the data source type name.
@@ -109,6 +111,10 @@ func (d *integrationModelsDataSource) Schema(_ context.Context, _ datasource.Sch
 					},
 				},
 			},
+			"slugs": listSlugsAttribute("models"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("models"),
 		},
 	}
 }
@@ -233,6 +239,12 @@ func (d *integrationModelsDataSource) Read(ctx context.Context, req datasource.R
 		modelAttrs = append(modelAttrs, mObj)
 	}
 
+	modelAttrs = applyListFilters(modelAttrs, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Slugs = listItemStrings(modelAttrs, "slug")
+
 	modelsList, diags := types.ListValue(modelObjType, modelAttrs)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
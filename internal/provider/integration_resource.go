@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -26,7 +27,10 @@ var (
 	_ resource.Resource                   = &integrationResource{}
 	_ resource.ResourceWithConfigure      = &integrationResource{}
 	_ resource.ResourceWithImportState    = &integrationResource{}
+	_ resource.ResourceWithMoveState      = &integrationResource{}
+	_ resource.ResourceWithModifyPlan     = &integrationResource{}
 	_ resource.ResourceWithValidateConfig = &integrationResource{}
+	_ resource.ResourceWithIdentity       = &integrationResource{}
 )
 
 // secretMappingAttrTypes is the shared object-type description for a
@@ -56,22 +60,24 @@ type integrationResource struct {
 
 // integrationResourceModel maps the resource schema data.
 type integrationResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Slug           types.String `tfsdk:"slug"`
-	Name           types.String `tfsdk:"name"`
-	AIProviderID   types.String `tfsdk:"ai_provider_id"`
-	Key            types.String `tfsdk:"key"`
-	KeyWriteOnly   types.String `tfsdk:"key_wo"`
-	KeyVersion     types.Int64  `tfsdk:"key_version"`
-	Configurations types.String `tfsdk:"configurations"`
-	Description    types.String `tfsdk:"description"`
-	WorkspaceID    types.String `tfsdk:"workspace_id"`
-	AllowAllModels types.Bool   `tfsdk:"allow_all_models"`
-	SecretMappings types.Set    `tfsdk:"secret_mappings"`
-	Type           types.String `tfsdk:"type"`
-	Status         types.String `tfsdk:"status"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ID                 types.String `tfsdk:"id"`
+	Slug               types.String `tfsdk:"slug"`
+	Name               types.String `tfsdk:"name"`
+	AIProviderID       types.String `tfsdk:"ai_provider_id"`
+	Key                types.String `tfsdk:"key"`
+	KeyWriteOnly       types.String `tfsdk:"key_wo"`
+	KeyVersion         types.Int64  `tfsdk:"key_version"`
+	Configurations     types.String `tfsdk:"configurations"`
+	Description        types.String `tfsdk:"description"`
+	WorkspaceID        types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug      types.String `tfsdk:"workspace_slug"`
+	AllowAllModels     types.Bool   `tfsdk:"allow_all_models"`
+	SecretMappings     types.Set    `tfsdk:"secret_mappings"`
+	Type               types.String `tfsdk:"type"`
+	Status             types.String `tfsdk:"status"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 // Metadata returns the resource type name.
@@ -144,9 +150,9 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"allow_all_models": schema.BoolAttribute{
 				Description: "Whether all models are enabled by default for this integration. When true (the default), all models for the provider are available. Set to false to restrict access to only models explicitly enabled via portkey_integration_model_access resources.",
 				Optional:    true,
@@ -198,6 +204,17 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Timestamp when the integration was last updated.",
 				Computed:    true,
 			},
+			"deletion_protection": deletionProtectionAttribute(),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *integrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id":           identityAttribute("Integration identifier (UUID)."),
+			"workspace_id": optionalIdentityAttribute("Workspace identifier (UUID), for workspace-level integrations."),
 		},
 	}
 }
@@ -222,6 +239,14 @@ func (r *integrationResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
+// ModifyPlan replaces the integration only when workspace_id moves to another
+// workspace, applies the provider-level deletion_protection default and warns
+// before a protected integration is destroyed.
+func (r *integrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+	modifyDeletionProtectionPlan(ctx, req, resp, r.client, "Integration")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -409,6 +434,8 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -486,12 +513,19 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	state.AllowAllModels = types.BoolValue(modelsResp.AllowAllModels)
 
+	// deletion_protection is provider-side only; fill in the default after import.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(defaultDeletionProtection(r.client))
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -520,6 +554,22 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// deletion_protection and the form of workspace_id are not sent to the
+	// API, so a change to only those keeps the rest of state.
+	if !planChangesAttributes(req.Plan, req.State, "name", "key", "key_version", "configurations", "description", "allow_all_models", "secret_mappings") {
+		state.WorkspaceID = plan.WorkspaceID
+		state.DeletionProtection = plan.DeletionProtection
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+		setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+		return
+	}
+
 	// Update existing integration
 	updateReq := client.UpdateIntegrationRequest{
 		Name: plan.Name.ValueString(),
@@ -651,8 +701,8 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		plan.Type = types.StringValue(integration.Type)
 	}
 
-	// Preserve workspace_id from state (workspace_id is immutable, RequiresReplace)
-	plan.WorkspaceID = state.WorkspaceID
+	// workspace_id keeps its planned value: it only changes in place when
+	// switching between the ID and the slug of the same workspace.
 
 	// Refresh secret_mappings from the PUT response to surface any
 	// server-side normalisation (e.g. slug vs. UUID resolution).
@@ -679,6 +729,8 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -691,6 +743,10 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	if !checkDeletionProtection(state.DeletionProtection, "Integration", state.Slug.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
 	// Delete existing integration
 	err := r.client.DeleteIntegration(ctx, state.Slug.ValueString())
 	if err != nil {
@@ -702,10 +758,29 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
-// ImportState imports the resource state.
+// ImportState imports the resource state by slug, or by identity.
 func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by slug
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	if req.ID == "" {
+		importSlugFromIdentity(ctx, req, resp, "integration", func(id, _ string) (string, error) {
+			integrations, err := r.client.ListIntegrations(ctx)
+			for _, integration := range integrations {
+				if integration.ID == id {
+					return integration.Slug, nil
+				}
+			}
+			return "", err
+		})
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), req.ID)...)
+}
+
+// MoveState moves portkey_integration state from another provider and restapi_object integrations.
+func (r *integrationResource) MoveState(_ context.Context) []resource.StateMover {
+	return importStateMovers(r,
+		attributeSource("portkey_integration", "%s", []string{"slug", "id"}),
+		restapiObjectSource("/integrations", restapiObjectSlug),
+	)
 }
 
 // ValidateConfig enforces cross-attribute invariants on secret_mappings that
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,9 +21,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &integrationWorkspaceAccessResource{}
-	_ resource.ResourceWithConfigure   = &integrationWorkspaceAccessResource{}
-	_ resource.ResourceWithImportState = &integrationWorkspaceAccessResource{}
+	_ resource.Resource                 = &integrationWorkspaceAccessResource{}
+	_ resource.ResourceWithConfigure    = &integrationWorkspaceAccessResource{}
+	_ resource.ResourceWithImportState  = &integrationWorkspaceAccessResource{}
+	_ resource.ResourceWithIdentity     = &integrationWorkspaceAccessResource{}
+	_ resource.ResourceWithModifyPlan   = &integrationWorkspaceAccessResource{}
+	_ resource.ResourceWithUpgradeState = &integrationWorkspaceAccessResource{}
 )
 
 // NewIntegrationWorkspaceAccessResource is a helper function to simplify the provider implementation.
@@ -40,6 +44,7 @@ type integrationWorkspaceAccessResourceModel struct {
 	ID            types.String `tfsdk:"id"`
 	IntegrationID types.String `tfsdk:"integration_id"`
 	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug types.String `tfsdk:"workspace_slug"`
 	Enabled       types.Bool   `tfsdk:"enabled"`
 	UsageLimits   types.List   `tfsdk:"usage_limits"`
 	RateLimits    types.List   `tfsdk:"rate_limits"`
@@ -56,6 +61,7 @@ func (r *integrationWorkspaceAccessResource) Metadata(_ context.Context, req res
 func (r *integrationWorkspaceAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages workspace access for a Portkey integration. Enables an integration to be used within a specific workspace, optionally with usage and rate limits.",
+		Version:     int64(len(integrationWorkspaceAccessStateUpgrades)),
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Resource identifier in format integration_id/workspace_id.",
@@ -74,10 +80,8 @@ func (r *integrationWorkspaceAccessResource) Schema(_ context.Context, _ resourc
 			"workspace_id": schema.StringAttribute{
 				Description: "The workspace ID to grant access to.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Whether the integration is enabled for this workspace. Defaults to true.",
 				Optional:    true,
@@ -97,11 +101,11 @@ func (r *integrationWorkspaceAccessResource) Schema(_ context.Context, _ resourc
 								stringvalidator.OneOf("cost", "tokens"),
 							},
 						},
-						"credit_limit": schema.Int64Attribute{
+						"credit_limit": schema.Float64Attribute{
 							Description: "The credit limit value.",
 							Optional:    true,
 						},
-						"alert_threshold": schema.Int64Attribute{
+						"alert_threshold": schema.Float64Attribute{
 							Description: "Alert threshold in dollars. Triggers email notification when usage reaches this amount.",
 							Optional:    true,
 						},
@@ -146,6 +150,16 @@ func (r *integrationWorkspaceAccessResource) Schema(_ context.Context, _ resourc
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *integrationWorkspaceAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"integration_id": identityAttribute("Integration slug or ID."),
+			"workspace_id":   identityAttribute("Workspace identifier (UUID)."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *integrationWorkspaceAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -166,6 +180,12 @@ func (r *integrationWorkspaceAccessResource) Configure(_ context.Context, req re
 	r.client = client
 }
 
+// ModifyPlan replaces the access grant only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *integrationWorkspaceAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *integrationWorkspaceAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -222,6 +242,8 @@ func (r *integrationWorkspaceAccessResource) Create(ctx context.Context, req res
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -269,6 +291,8 @@ func (r *integrationWorkspaceAccessResource) Read(ctx context.Context, req resou
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -323,6 +347,8 @@ func (r *integrationWorkspaceAccessResource) Update(ctx context.Context, req res
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -371,8 +397,10 @@ func (r *integrationWorkspaceAccessResource) Delete(ctx context.Context, req res
 
 // ImportState imports the resource state.
 func (r *integrationWorkspaceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics, "integration_id", "workspace_id")
+
 	// Import ID should be in format: integration_id/workspace_id
-	parts := strings.Split(req.ID, "/")
+	parts := strings.Split(id, "/")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid import ID",
@@ -381,9 +409,28 @@ func (r *integrationWorkspaceAccessResource) ImportState(ctx context.Context, re
 		return
 	}
 
+	checkImportedWorkspace(ctx, r.client, parts[1], &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// integrationWorkspaceAccessStateUpgrades upgrades integration workspace
+// access state from prior schema versions.
+var integrationWorkspaceAccessStateUpgrades = [][]stateUpgradeStep{
+	// 0 → 1: usage_limits credit_limit and alert_threshold became Float64.
+	// The numbers carry over as written.
+	nil,
+}
+
+// UpgradeState upgrades integration workspace access state written with prior
+// schema versions.
+func (r *integrationWorkspaceAccessResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(integrationWorkspaceAccessStateUpgrades)
 }
 
 // Helper functions
@@ -29,10 +29,12 @@ type integrationWorkspacesDataSource struct {
 
 // integrationWorkspacesDataSourceModel maps the data source schema data.
 type integrationWorkspacesDataSourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	IntegrationID types.String `tfsdk:"integration_id"`
-	Total         types.Int64  `tfsdk:"total"`
-	Workspaces    types.List   `tfsdk:"workspaces"`
+	ID            types.String      `tfsdk:"id"`
+	IntegrationID types.String      `tfsdk:"integration_id"`
+	Total         types.Int64       `tfsdk:"total"`
+	Workspaces    types.List        `tfsdk:"workspaces"`
+	Filter        []listFilterModel `tfsdk:"filter"`
+	IDs           types.List        `tfsdk:"ids"`
 }
 
 // Metadata returns the data source type name.
@@ -79,11 +81,11 @@ func (d *integrationWorkspacesDataSource) Schema(_ context.Context, _ datasource
 										Description: "Type of usage limit: 'cost' or 'tokens'.",
 										Computed:    true,
 									},
-									"credit_limit": schema.Int64Attribute{
+									"credit_limit": schema.Float64Attribute{
 										Description: "The credit limit value.",
 										Computed:    true,
 									},
-									"alert_threshold": schema.Int64Attribute{
+									"alert_threshold": schema.Float64Attribute{
 										Description: "Alert threshold in dollars.",
 										Computed:    true,
 									},
@@ -117,6 +119,10 @@ func (d *integrationWorkspacesDataSource) Schema(_ context.Context, _ datasource
 					},
 				},
 			},
+			"ids": listIDsAttribute("workspaces"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("workspaces"),
 		},
 	}
 }
@@ -206,6 +212,12 @@ func (d *integrationWorkspacesDataSource) Read(ctx context.Context, req datasour
 		workspaceAttrs = append(workspaceAttrs, wsObj)
 	}
 
+	workspaceAttrs = applyListFilters(workspaceAttrs, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(workspaceAttrs, "id")
+
 	workspacesList, diags := types.ListValue(workspaceObjType, workspaceAttrs)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -29,6 +29,9 @@ type integrationsDataSource struct {
 // integrationsDataSourceModel maps the data source schema data.
 type integrationsDataSourceModel struct {
 	Integrations []integrationModel `tfsdk:"integrations"`
+	Filter       []listFilterModel  `tfsdk:"filter"`
+	IDs          types.List         `tfsdk:"ids"`
+	Slugs        types.List         `tfsdk:"slugs"`
 }
 
 // integrationModel maps integration data
@@ -93,6 +96,11 @@ func (d *integrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 					},
 				},
 			},
+			"ids":   listIDsAttribute("integrations"),
+			"slugs": listSlugsAttribute("integrations"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("integrations"),
 		},
 	}
 }
@@ -119,6 +127,11 @@ func (d *integrationsDataSource) Configure(_ context.Context, req datasource.Con
 func (d *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state integrationsDataSourceModel
 
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get integrations from Portkey API
 	integrations, err := d.client.ListIntegrations(ctx)
 	if err != nil {
@@ -152,6 +165,13 @@ func (d *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		state.Integrations = append(state.Integrations, integrationState)
 	}
 
+	state.Integrations = applyListFilters(state.Integrations, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Integrations, "id")
+	state.Slugs = listItemStrings(state.Integrations, "slug")
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
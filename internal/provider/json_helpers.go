@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jsonSemanticallyEqual reports whether two JSON documents decode to the same
+// value, ignoring whitespace and key order. Invalid JSON is never equal.
+func jsonSemanticallyEqual(a, b string) bool {
+	var av, bv interface{}
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	aBytes, _ := json.Marshal(av)
+	bBytes, _ := json.Marshal(bv)
+	return string(aBytes) == string(bBytes)
+}
+
+// jsonObjectToString maps an API-returned JSON object onto a string attribute.
+// A nil map is treated as {}; see jsonValueToString for the formatting and
+// empty-value rules.
+func jsonObjectToString(prior types.String, value map[string]interface{}) types.String {
+	if value == nil {
+		value = map[string]interface{}{}
+	}
+	return jsonValueToString(prior, value)
+}
+
+// jsonValueToString maps an API-returned JSON value onto a string attribute.
+//
+// The prior value is kept when it is semantically equal to the API value, so
+// the user's formatting survives a refresh. An empty value (nil, {} or [])
+// maps to null unless prior already held an equally empty document.
+func jsonValueToString(prior types.String, value interface{}) types.String {
+	priorSet := !prior.IsNull() && !prior.IsUnknown()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return prior
+	}
+	if priorSet && jsonSemanticallyEqual(prior.ValueString(), string(data)) {
+		return prior
+	}
+	switch string(data) {
+	case "null", "{}", "[]":
+		return types.StringNull()
+	}
+	return types.StringValue(string(data))
+}
+
+// rfc3339ToString maps an API-returned timestamp onto a string attribute,
+// keeping the prior value when it names the same instant (e.g. the user wrote
+// "+00:00" and the API returns "Z").
+func rfc3339ToString(prior types.String, value *time.Time) types.String {
+	if value == nil || value.IsZero() {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if t, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && t.Equal(*value) {
+			return prior
+		}
+	}
+	return types.StringValue(value.Format("2006-01-02T15:04:05Z07:00"))
+}
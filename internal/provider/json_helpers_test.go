@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONObjectToString_PreservesFormatting(t *testing.T) {
+	prior := types.StringValue("{\n  \"b\": 2,\n  \"a\": 1\n}")
+	got := jsonObjectToString(prior, map[string]interface{}{"a": float64(1), "b": float64(2)})
+	if !got.Equal(prior) {
+		t.Errorf("expected prior formatting to be kept, got %q", got.ValueString())
+	}
+}
+
+func TestJSONObjectToString_DetectsDrift(t *testing.T) {
+	prior := types.StringValue(`{"a":1}`)
+	got := jsonObjectToString(prior, map[string]interface{}{"a": float64(2)})
+	if got.ValueString() != `{"a":2}` {
+		t.Errorf("expected API value on drift, got %q", got.ValueString())
+	}
+}
+
+func TestJSONObjectToString_Empty(t *testing.T) {
+	if got := jsonObjectToString(types.StringNull(), nil); !got.IsNull() {
+		t.Errorf("expected null for nil object with null prior, got %q", got.ValueString())
+	}
+	if got := jsonObjectToString(types.StringValue(`{"a":1}`), map[string]interface{}{}); !got.IsNull() {
+		t.Errorf("expected null when object was cleared externally, got %q", got.ValueString())
+	}
+	prior := types.StringValue("{ }")
+	if got := jsonObjectToString(prior, nil); !got.Equal(prior) {
+		t.Errorf("expected an empty prior object to be kept, got %q", got.ValueString())
+	}
+}
+
+func TestRFC3339ToString(t *testing.T) {
+	ts := time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	prior := types.StringValue("2026-12-31T23:59:59+00:00")
+	if got := rfc3339ToString(prior, &ts); !got.Equal(prior) {
+		t.Errorf("expected prior spelling of the same instant to be kept, got %q", got.ValueString())
+	}
+
+	if got := rfc3339ToString(types.StringValue("2027-01-01T00:00:00Z"), &ts); got.ValueString() != "2026-12-31T23:59:59Z" {
+		t.Errorf("expected API value on drift, got %q", got.ValueString())
+	}
+
+	if got := rfc3339ToString(prior, nil); !got.IsNull() {
+		t.Errorf("expected null when the API has no expiry, got %q", got.ValueString())
+	}
+}
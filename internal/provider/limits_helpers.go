@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
@@ -18,8 +20,8 @@ import (
 var (
 	workspaceUsageLimitsAttrTypes = map[string]attr.Type{
 		"type":            types.StringType,
-		"credit_limit":    types.Int64Type,
-		"alert_threshold": types.Int64Type,
+		"credit_limit":    types.Float64Type,
+		"alert_threshold": types.Float64Type,
 		"periodic_reset":  types.StringType,
 	}
 
@@ -35,10 +37,10 @@ var (
 
 // workspaceUsageLimitsModel maps a workspace usage_limits block
 type workspaceUsageLimitsModel struct {
-	Type           types.String `tfsdk:"type"`
-	CreditLimit    types.Int64  `tfsdk:"credit_limit"`
-	AlertThreshold types.Int64  `tfsdk:"alert_threshold"`
-	PeriodicReset  types.String `tfsdk:"periodic_reset"`
+	Type           types.String  `tfsdk:"type"`
+	CreditLimit    types.Float64 `tfsdk:"credit_limit"`
+	AlertThreshold types.Float64 `tfsdk:"alert_threshold"`
+	PeriodicReset  types.String  `tfsdk:"periodic_reset"`
 }
 
 // workspaceRateLimitsModel maps a workspace rate_limits block
@@ -73,11 +75,11 @@ func buildWorkspaceLimitsFromPlan(ctx context.Context, plan *workspaceResourceMo
 				PeriodicReset: ul.PeriodicReset.ValueString(),
 			}
 			if !ul.CreditLimit.IsNull() {
-				v := int(ul.CreditLimit.ValueInt64())
+				v := ul.CreditLimit.ValueFloat64()
 				clientUL.CreditLimit = &v
 			}
 			if !ul.AlertThreshold.IsNull() {
-				v := int(ul.AlertThreshold.ValueInt64())
+				v := ul.AlertThreshold.ValueFloat64()
 				clientUL.AlertThreshold = &v
 			}
 			usageLimits = append(usageLimits, clientUL)
@@ -134,18 +136,18 @@ func workspaceUsageLimitsToTerraformList(limits []client.IntegrationWorkspaceUsa
 	for _, ul := range limits {
 		attrs := map[string]attr.Value{
 			"type":            types.StringValue(ul.Type),
-			"credit_limit":    types.Int64Null(),
-			"alert_threshold": types.Int64Null(),
+			"credit_limit":    types.Float64Null(),
+			"alert_threshold": types.Float64Null(),
 			"periodic_reset":  types.StringNull(),
 		}
 		if ul.PeriodicReset != "" {
 			attrs["periodic_reset"] = types.StringValue(ul.PeriodicReset)
 		}
 		if ul.CreditLimit != nil {
-			attrs["credit_limit"] = types.Int64Value(int64(*ul.CreditLimit))
+			attrs["credit_limit"] = types.Float64Value(*ul.CreditLimit)
 		}
 		if ul.AlertThreshold != nil {
-			attrs["alert_threshold"] = types.Int64Value(int64(*ul.AlertThreshold))
+			attrs["alert_threshold"] = types.Float64Value(*ul.AlertThreshold)
 		}
 
 		objVal, d := types.ObjectValue(workspaceUsageLimitsAttrTypes, attrs)
@@ -225,11 +227,11 @@ func marshalWorkspaceLimitsForUpdate(ctx context.Context, plan *workspaceResourc
 				PeriodicReset: ul.PeriodicReset.ValueString(),
 			}
 			if !ul.CreditLimit.IsNull() {
-				v := int(ul.CreditLimit.ValueInt64())
+				v := ul.CreditLimit.ValueFloat64()
 				clientUL.CreditLimit = &v
 			}
 			if !ul.AlertThreshold.IsNull() {
-				v := int(ul.AlertThreshold.ValueInt64())
+				v := ul.AlertThreshold.ValueFloat64()
 				clientUL.AlertThreshold = &v
 			}
 			limits = append(limits, clientUL)
@@ -418,6 +420,66 @@ func apiKeyRotationPolicyToTerraform(rp *client.RotationPolicy) (types.Object, d
 	return obj, diags
 }
 
+// validateAPIKeyUsageLimitsConfig enforces the cross-field rules of an
+// API-key-format usage_limits block: credit_limit is required when the block
+// is present, periodic_reset and periodic_reset_days are mutually exclusive,
+// and alert_threshold must not exceed credit_limit. attrPath is the path of
+// the usage_limits attribute, used to anchor the diagnostics.
+//
+// Individual checks are skipped when a value is Unknown (unresolved
+// expression) — validation re-runs at apply time once values are known.
+func validateAPIKeyUsageLimitsConfig(obj types.Object, attrPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if obj.IsNull() || obj.IsUnknown() {
+		return diags
+	}
+
+	attrs := obj.Attributes()
+
+	creditLimit, hasCL := attrs["credit_limit"]
+	// Only enforce when credit_limit is known-null (deliberately omitted), not Unknown.
+	if !hasCL || (creditLimit.IsNull() && !creditLimit.IsUnknown()) {
+		diags.AddAttributeError(
+			attrPath.AtName("credit_limit"),
+			"Missing Required Attribute",
+			"credit_limit is required when usage_limits is configured.",
+		)
+	}
+
+	periodicReset := attrs["periodic_reset"]
+	periodicResetDays := attrs["periodic_reset_days"]
+	periodicResetSet := periodicReset != nil && !periodicReset.IsNull() && !periodicReset.IsUnknown()
+	periodicResetDaysSet := periodicResetDays != nil && !periodicResetDays.IsNull() && !periodicResetDays.IsUnknown()
+	if periodicResetSet && periodicResetDaysSet {
+		diags.AddAttributeError(
+			attrPath.AtName("periodic_reset_days"),
+			"Conflicting Attributes",
+			"periodic_reset and periodic_reset_days are mutually exclusive. Set one or the other, not both.",
+		)
+	}
+
+	alertThreshold := attrs["alert_threshold"]
+	creditLimitKnown := hasCL && creditLimit != nil && !creditLimit.IsNull() && !creditLimit.IsUnknown()
+	alertThresholdKnown := alertThreshold != nil && !alertThreshold.IsNull() && !alertThreshold.IsUnknown()
+	if creditLimitKnown && alertThresholdKnown {
+		if clVal, ok := creditLimit.(types.Int64); ok {
+			if atVal, ok := alertThreshold.(types.Int64); ok {
+				if atVal.ValueInt64() > clVal.ValueInt64() {
+					diags.AddAttributeError(
+						attrPath.AtName("alert_threshold"),
+						"Invalid Attribute Value",
+						fmt.Sprintf("alert_threshold (%d) must be less than or equal to credit_limit (%d).",
+							atVal.ValueInt64(), clVal.ValueInt64()),
+					)
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
 // terraformToAPIKeyUsageLimits converts a Terraform Object to client *UsageLimits.
 // Uses safe type assertions with comma-ok pattern.
 func terraformToAPIKeyUsageLimits(obj types.Object) *client.UsageLimits {
@@ -531,6 +593,51 @@ func apiKeyRateLimitsToTerraformList(limits []client.RateLimit) (types.List, dia
 	return list, diags
 }
 
+// reconcileAPIKeyUsageLimits maps an API-returned *UsageLimits onto state.
+//
+// When prior holds usage_limits, the user-configured Optional fields are kept
+// from prior (avoids stale API data overwriting values just applied) and only
+// the server-computed next_usage_reset_at is taken from the API response.
+// When prior is null or unknown (Create, Import, or just-cleared), the full
+// API value is used. A nil API value always maps to a null object.
+func reconcileAPIKeyUsageLimits(prior types.Object, ul *client.UsageLimits) (types.Object, diag.Diagnostics) {
+	if ul == nil {
+		return types.ObjectNull(apiKeyUsageLimitsAttrTypes), nil
+	}
+	if prior.IsNull() || prior.IsUnknown() {
+		return apiKeyUsageLimitsToTerraform(ul)
+	}
+
+	priorUL := terraformToAPIKeyUsageLimits(prior)
+	return apiKeyUsageLimitsToTerraform(&client.UsageLimits{
+		Type:              priorUL.Type,
+		CreditLimit:       priorUL.CreditLimit,
+		AlertThreshold:    priorUL.AlertThreshold,
+		PeriodicReset:     priorUL.PeriodicReset,
+		PeriodicResetDays: priorUL.PeriodicResetDays,
+		NextUsageResetAt:  ul.NextUsageResetAt,
+	})
+}
+
+// reconcileAPIKeyRateLimits maps API-returned rate limits onto state.
+//
+// Distinguishes nil (field absent → null) from non-nil empty: the API returns
+// [] for every object regardless of whether rate_limits was ever configured,
+// so when prior is null or unknown (user never configured rate_limits) an
+// empty response keeps null to prevent a perpetual "empty list vs null" diff.
+func reconcileAPIKeyRateLimits(prior types.List, limits []client.RateLimit) (types.List, diag.Diagnostics) {
+	if limits == nil {
+		return types.ListNull(apiKeyRateLimitsObjectType), nil
+	}
+	if len(limits) == 0 {
+		if prior.IsNull() || prior.IsUnknown() {
+			return types.ListNull(apiKeyRateLimitsObjectType), nil
+		}
+		return types.ListValueMust(apiKeyRateLimitsObjectType, []attr.Value{}), nil
+	}
+	return apiKeyRateLimitsToTerraformList(limits)
+}
+
 // terraformToAPIKeyRateLimits converts a Terraform List to client []RateLimit.
 // Uses safe type assertions with comma-ok pattern.
 func terraformToAPIKeyRateLimits(list types.List) []client.RateLimit {
@@ -597,11 +704,11 @@ func buildIntegrationWorkspaceLimitsFromPlan(ctx context.Context, plan *integrat
 				PeriodicReset: ul.PeriodicReset.ValueString(),
 			}
 			if !ul.CreditLimit.IsNull() {
-				v := int(ul.CreditLimit.ValueInt64())
+				v := ul.CreditLimit.ValueFloat64()
 				clientUL.CreditLimit = &v
 			}
 			if !ul.AlertThreshold.IsNull() {
-				v := int(ul.AlertThreshold.ValueInt64())
+				v := ul.AlertThreshold.ValueFloat64()
 				clientUL.AlertThreshold = &v
 			}
 			usageLimits = append(usageLimits, clientUL)
@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// listFilterModel maps one filter block of a list data source.
+type listFilterModel struct {
+	Name   types.String   `tfsdk:"name"`
+	Values []types.String `tfsdk:"values"`
+	Regex  types.Bool     `tfsdk:"regex"`
+}
+
+// listFilterBlock returns the filter block shared by list data sources. kind
+// is the plural noun of the listed objects, e.g. "configs".
+func listFilterBlock(kind string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Only return " + kind + " matching this filter. Repeat the block to combine filters; " +
+			"an item must match every filter. A filter matches when the attribute matches any of its values.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Name of a string, number or boolean attribute of the listed items (e.g. `name` or `status`). " +
+						"Lists of strings match when any element matches.",
+					Required: true,
+				},
+				"values": schema.ListAttribute{
+					Description: "Values to match. Glob patterns are supported: `*` matches any sequence of characters and `?` a single one.",
+					Required:    true,
+					ElementType: types.StringType,
+				},
+				"regex": schema.BoolAttribute{
+					Description: "Treat values as RE2 regular expressions instead of glob patterns. " +
+						"A regular expression matches anywhere in the value unless anchored with `^` and `$`.",
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// listIDsAttribute returns the computed ids attribute of list data sources.
+func listIDsAttribute(kind string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "IDs of the returned " + kind + ", in the same order; null for an item without an ID.",
+		Computed:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// listSlugsAttribute returns the computed slugs attribute of list data sources.
+func listSlugsAttribute(kind string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "Slugs of the returned " + kind + ", in the same order; null for an item without a slug.",
+		Computed:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// applyListFilters returns the items matching every filter. Items are either
+// structs with tfsdk tags or object values. Invalid filters are reported as
+// errors on the filter block.
+func applyListFilters[T any](items []T, filters []listFilterModel, diags *diag.Diagnostics) []T {
+	if len(filters) == 0 {
+		return items
+	}
+
+	matchers := make([]listFilterMatcher, 0, len(filters))
+	for i, f := range filters {
+		m, err := newListFilterMatcher(f)
+		if err == nil {
+			err = checkListFilterName[T](items, m.name)
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("filter").AtListIndex(i),
+				"Invalid Filter",
+				err.Error(),
+			)
+			continue
+		}
+		matchers = append(matchers, m)
+	}
+	if diags.HasError() {
+		return items
+	}
+
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		keep := true
+		for _, m := range matchers {
+			v, _ := listItemAttribute(item, m.name)
+			if !m.matches(v) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// listItemStrings collects the string attribute name of each item, for the
+// ids and slugs attributes. Items without the attribute contribute a null, so
+// the list stays index-aligned with the items.
+func listItemStrings[T any](items []T, name string) types.List {
+	values := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		v, _ := listItemAttribute(item, name)
+		s, ok := v.(basetypes.StringValue)
+		if !ok || s.IsUnknown() {
+			s = types.StringNull()
+		}
+		values = append(values, s)
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+// listFilterMatcher is a compiled filter block.
+type listFilterMatcher struct {
+	name     string
+	patterns []*regexp.Regexp
+}
+
+// newListFilterMatcher compiles the values of f into regular expressions.
+func newListFilterMatcher(f listFilterModel) (listFilterMatcher, error) {
+	m := listFilterMatcher{name: f.Name.ValueString()}
+	for _, v := range f.Values {
+		expr := v.ValueString()
+		if !f.Regex.ValueBool() {
+			expr = globToRegexp(expr)
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return m, fmt.Errorf("filter %q: invalid regular expression %q: %s", m.name, v.ValueString(), err)
+		}
+		m.patterns = append(m.patterns, re)
+	}
+	return m, nil
+}
+
+// matches reports whether any pattern matches v, or any element of v when it
+// is a list or set of strings. Null values never match.
+func (m listFilterMatcher) matches(v attr.Value) bool {
+	for _, s := range listFilterStrings(v) {
+		for _, re := range m.patterns {
+			if re.MatchString(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// globToRegexp converts a glob pattern into an anchored regular expression.
+func globToRegexp(glob string) string {
+	expr := regexp.QuoteMeta(glob)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	return "^" + expr + "$"
+}
+
+// listFilterStrings returns the string forms of a filterable value, and nil
+// for null, unknown or unsupported values.
+func listFilterStrings(v attr.Value) []string {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	switch v := v.(type) {
+	case basetypes.StringValue:
+		return []string{v.ValueString()}
+	case basetypes.BoolValue:
+		return []string{strconv.FormatBool(v.ValueBool())}
+	case basetypes.Int64Value:
+		return []string{strconv.FormatInt(v.ValueInt64(), 10)}
+	case basetypes.Float64Value:
+		return []string{strconv.FormatFloat(v.ValueFloat64(), 'f', -1, 64)}
+	case basetypes.ListValue:
+		return listFilterElementStrings(v.Elements())
+	case basetypes.SetValue:
+		return listFilterElementStrings(v.Elements())
+	}
+	return nil
+}
+
+func listFilterElementStrings(elems []attr.Value) []string {
+	var result []string
+	for _, e := range elems {
+		if s, ok := e.(basetypes.StringValue); ok && !s.IsNull() && !s.IsUnknown() {
+			result = append(result, s.ValueString())
+		}
+	}
+	return result
+}
+
+// listFilterable reports whether values of type t can be filtered on.
+func listFilterable(t attr.Type) bool {
+	switch t := t.(type) {
+	case basetypes.StringType, basetypes.BoolType, basetypes.Int64Type, basetypes.Float64Type:
+		return true
+	case basetypes.ListType:
+		return t.ElemType != nil && t.ElemType.Equal(types.StringType)
+	case basetypes.SetType:
+		return t.ElemType != nil && t.ElemType.Equal(types.StringType)
+	}
+	return false
+}
+
+// listFilterableField reports whether a model field of type t can be filtered
+// on. List and set fields are accepted whatever their element type, since the
+// Go type does not carry it; only string elements ever match.
+func listFilterableField(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeOf(types.String{}), reflect.TypeOf(types.Bool{}), reflect.TypeOf(types.Int64{}),
+		reflect.TypeOf(types.Float64{}), reflect.TypeOf(types.List{}), reflect.TypeOf(types.Set{}):
+		return true
+	}
+	return false
+}
+
+// checkListFilterName returns an error listing the valid names when name is
+// not a filterable attribute of the items.
+func checkListFilterName[T any](items []T, name string) error {
+	names := listFilterNames[T](items)
+	if names == nil {
+		// Object items with no elements: nothing to check against.
+		return nil
+	}
+	for _, n := range names {
+		if n == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot filter on %q. Valid names: %s", name, strings.Join(names, ", "))
+}
+
+// listFilterNames returns the sorted names of the filterable attributes of
+// the items, or nil when they cannot be determined.
+func listFilterNames[T any](items []T) []string {
+	names := []string{}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() == reflect.Struct && !t.Implements(reflect.TypeOf((*attr.Value)(nil)).Elem()) {
+		for i := 0; i < t.NumField(); i++ {
+			tag := t.Field(i).Tag.Get("tfsdk")
+			if tag == "" || tag == "-" {
+				continue
+			}
+			if listFilterableField(t.Field(i).Type) {
+				names = append(names, tag)
+			}
+		}
+	} else {
+		if len(items) == 0 {
+			return nil
+		}
+		obj, ok := any(items[0]).(basetypes.ObjectValue)
+		if !ok {
+			return nil
+		}
+		for n, typ := range obj.AttributeTypes(nil) {
+			if listFilterable(typ) {
+				names = append(names, n)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// listItemAttribute returns the attribute name of item, which is either a
+// struct with tfsdk tags or an object value.
+func listItemAttribute(item any, name string) (attr.Value, bool) {
+	if obj, ok := item.(basetypes.ObjectValue); ok {
+		v, ok := obj.Attributes()[name]
+		return v, ok
+	}
+
+	rv := reflect.ValueOf(item)
+	if rv.Kind() != reflect.Struct {
+		return nil, false
+	}
+	for i := 0; i < rv.NumField(); i++ {
+		if rv.Type().Field(i).Tag.Get("tfsdk") != name {
+			continue
+		}
+		v, ok := rv.Field(i).Interface().(attr.Value)
+		return v, ok
+	}
+	return nil, false
+}
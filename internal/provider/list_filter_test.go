@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testListFilter(name string, regex bool, values ...string) listFilterModel {
+	f := listFilterModel{Name: types.StringValue(name), Regex: types.BoolValue(regex)}
+	for _, v := range values {
+		f.Values = append(f.Values, types.StringValue(v))
+	}
+	return f
+}
+
+func testConfigSummaries() []configSummaryModel {
+	return []configSummaryModel{
+		{ID: types.StringValue("1"), Slug: types.StringValue("pc-prod-routing"), Name: types.StringValue("prod-routing"), Status: types.StringValue("active"), IsDefault: types.BoolValue(true)},
+		{ID: types.StringValue("2"), Slug: types.StringValue("pc-prod-cache"), Name: types.StringValue("prod-cache"), Status: types.StringValue("archived"), IsDefault: types.BoolValue(false)},
+		{ID: types.StringValue("3"), Slug: types.StringValue("pc-dev-routing"), Name: types.StringValue("dev-routing"), Status: types.StringValue("active"), IsDefault: types.BoolValue(false)},
+	}
+}
+
+func TestApplyListFilters_GlobAndMultipleFilters(t *testing.T) {
+	var diags diag.Diagnostics
+	got := applyListFilters(testConfigSummaries(), []listFilterModel{
+		testListFilter("name", false, "prod-*"),
+		testListFilter("status", false, "active"),
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(got) != 1 || got[0].ID.ValueString() != "1" {
+		t.Fatalf("expected only config 1, got %+v", got)
+	}
+}
+
+func TestApplyListFilters_AnyValueMatches(t *testing.T) {
+	var diags diag.Diagnostics
+	got := applyListFilters(testConfigSummaries(), []listFilterModel{
+		testListFilter("slug", false, "pc-dev-?outing", "pc-prod-cache"),
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if ids := listItemStrings(got, "id"); len(ids.Elements()) != 2 {
+		t.Fatalf("expected 2 configs, got %v", ids)
+	}
+}
+
+func TestApplyListFilters_RegexAndBool(t *testing.T) {
+	var diags diag.Diagnostics
+	got := applyListFilters(testConfigSummaries(), []listFilterModel{
+		testListFilter("name", true, "routing$"),
+		testListFilter("is_default", false, "false"),
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(got) != 1 || got[0].ID.ValueString() != "3" {
+		t.Fatalf("expected only config 3, got %+v", got)
+	}
+}
+
+func TestApplyListFilters_InvalidFilters(t *testing.T) {
+	var diags diag.Diagnostics
+	applyListFilters(testConfigSummaries(), []listFilterModel{testListFilter("colour", false, "red")}, &diags)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "Valid names: created_at, id, is_default") {
+		t.Errorf("expected an unknown-name error listing valid names, got %v", diags)
+	}
+
+	diags = nil
+	applyListFilters(testConfigSummaries(), []listFilterModel{testListFilter("name", true, "(")}, &diags)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "invalid regular expression") {
+		t.Errorf("expected an invalid regex error, got %v", diags)
+	}
+}
+
+func TestApplyListFilters_ObjectItemsAndStringLists(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"id":     types.StringType,
+		"scopes": types.ListType{ElemType: types.StringType},
+	}
+	obj := func(id string, scopes ...string) attr.Value {
+		elems := make([]attr.Value, 0, len(scopes))
+		for _, s := range scopes {
+			elems = append(elems, types.StringValue(s))
+		}
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"id":     types.StringValue(id),
+			"scopes": types.ListValueMust(types.StringType, elems),
+		})
+	}
+	items := []attr.Value{obj("a", "logs.view"), obj("b", "configs.read", "configs.write")}
+
+	var diags diag.Diagnostics
+	got := applyListFilters(items, []listFilterModel{testListFilter("scopes", false, "configs.*")}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	ids := listItemStrings(got, "id")
+	if len(ids.Elements()) != 1 || ids.Elements()[0].(types.String).ValueString() != "b" {
+		t.Errorf("expected only b, got %v", ids)
+	}
+}
+
+func TestListItemStrings_KeepsIndexAlignment(t *testing.T) {
+	items := testConfigSummaries()
+	items[1].Slug = types.StringNull()
+
+	slugs := listItemStrings(items, "slug")
+	want := []types.String{types.StringValue("pc-prod-routing"), types.StringNull(), types.StringValue("pc-dev-routing")}
+	if len(slugs.Elements()) != len(want) {
+		t.Fatalf("expected %d slugs, got %v", len(want), slugs)
+	}
+	for i, w := range want {
+		if got := slugs.Elements()[i]; !got.Equal(w) {
+			t.Errorf("slug %d = %v, want %v", i, got, w)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// listWorkspaceScope says whether a list resource takes a workspace_id
+// argument.
+type listWorkspaceScope int
+
+const (
+	listWorkspaceNone listWorkspaceScope = iota
+	listWorkspaceOptional
+	listWorkspaceRequired
+)
+
+// listResourceField is a string attribute of listed objects that list blocks
+// can filter on with a glob pattern.
+type listResourceField[T any] struct {
+	// description completes "Only list <kind> whose ...", e.g. "name".
+	description string
+	value       func(T) string
+}
+
+// listResourceDefinition describes how a list resource enumerates the objects
+// of one managed resource type for `terraform query`.
+type listResourceDefinition[T any] struct {
+	// typeName is the managed resource type name without the provider
+	// prefix, e.g. "config".
+	typeName string
+	// kind is the plural noun of the listed objects, e.g. "configs", and
+	// title its title-cased form used in diagnostics.
+	kind, title string
+
+	workspaceScope listWorkspaceScope
+	// list returns the objects, within workspaceID when it is set.
+	list func(ctx context.Context, c *client.Client, workspaceID string) ([]T, error)
+	// fields are the glob filter arguments, keyed by argument name.
+	fields map[string]listResourceField[T]
+	// identity returns the identity attribute values of an object; empty
+	// values are left null. workspaceID is the workspace_id argument, for
+	// objects the API returns without one.
+	identity func(item T, workspaceID string) map[string]string
+	// state optionally returns further state attribute values the managed
+	// resource's Read needs, such as the slug of an object identified by id.
+	state       func(item T) map[string]string
+	displayName func(T) string
+	// resource creates the managed resource whose Read fills in full objects
+	// when Terraform asks for them.
+	resource func() resource.Resource
+}
+
+// portkeyListResource implements list.ListResource from a definition.
+type portkeyListResource[T any] struct {
+	def    listResourceDefinition[T]
+	client *client.Client
+}
+
+// newListResource returns a list resource factory for def.
+func newListResource[T any](def listResourceDefinition[T]) list.ListResource {
+	return &portkeyListResource[T]{def: def}
+}
+
+// Metadata returns the list resource type name, which is that of the managed
+// resource it lists.
+func (r *portkeyListResource[T]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.def.typeName
+}
+
+// ListResourceConfigSchema defines the arguments of list blocks.
+func (r *portkeyListResource[T]) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := map[string]listschema.Attribute{}
+
+	switch r.def.workspaceScope {
+	case listWorkspaceRequired:
+		attributes["workspace_id"] = listschema.StringAttribute{
+			Description: "ID or slug of the workspace to list " + r.def.kind + " from.",
+			Required:    true,
+		}
+	case listWorkspaceOptional:
+		attributes["workspace_id"] = listschema.StringAttribute{
+			Description: "ID or slug of a workspace to list " + r.def.kind + " from. Defaults to every workspace the API key can see.",
+			Optional:    true,
+		}
+	}
+
+	for name, field := range r.def.fields {
+		attributes[name] = listschema.StringAttribute{
+			Description: "Only list " + r.def.kind + " whose " + field.description + " matches this pattern. " +
+				"`*` matches any sequence of characters and `?` a single one.",
+			Optional: true,
+		}
+	}
+
+	resp.Schema = listschema.Schema{
+		Description: "Lists existing " + r.def.kind + " so `terraform query` can generate configuration and import blocks for them.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (r *portkeyListResource[T]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams the objects matching the list block.
+func (r *portkeyListResource[T]) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	workspaceID := ""
+	if r.def.workspaceScope != listWorkspaceNone {
+		var v types.String
+		diags.Append(req.Config.GetAttribute(ctx, path.Root("workspace_id"), &v)...)
+		workspaceID = v.ValueString()
+	}
+
+	patterns := map[string]*regexp.Regexp{}
+	for name := range r.def.fields {
+		var v types.String
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(name), &v)...)
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		patterns[name] = regexp.MustCompile(globToRegexp(v.ValueString()))
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := r.def.list(ctx, r.client, workspaceID)
+	if err != nil {
+		diags.AddError(
+			"Unable to List Portkey "+r.def.title,
+			err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, item := range items {
+			if !r.matches(item, patterns) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+			if !push(r.result(ctx, req, item, workspaceID)) {
+				return
+			}
+		}
+	}
+}
+
+// matches reports whether item matches every filter argument.
+func (r *portkeyListResource[T]) matches(item T, patterns map[string]*regexp.Regexp) bool {
+	for name, re := range patterns {
+		if !re.MatchString(r.def.fields[name].value(item)) {
+			return false
+		}
+	}
+	return true
+}
+
+// result builds the list result of item: its identity and, when requested,
+// the full object as read by the managed resource.
+func (r *portkeyListResource[T]) result(ctx context.Context, req list.ListRequest, item T, workspaceID string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = r.def.displayName(item)
+
+	identity := r.def.identity(item, workspaceID)
+	names := make([]string, 0, len(identity))
+	for name := range identity {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := identity[name]
+		if value == "" {
+			delete(identity, name)
+			continue
+		}
+		if name == "workspace_id" {
+			value = identityWorkspaceID(ctx, r.client, value)
+			identity[name] = value
+		}
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	if result.Diagnostics.HasError() || !req.IncludeResource {
+		return result
+	}
+
+	attrs := identity
+	if r.def.state != nil {
+		attrs = r.def.state(item)
+		for name, value := range identity {
+			attrs[name] = value
+		}
+	}
+	r.readResource(ctx, attrs, &result)
+	return result
+}
+
+// readResource fills result.Resource by running the managed resource's Read
+// on a state holding only attrs, the identity attributes and those the
+// definition's state adds, as an import would.
+func (r *portkeyListResource[T]) readResource(ctx context.Context, attrs map[string]string, result *list.ListResult) {
+	res := r.def.resource()
+	if withConfigure, ok := res.(resource.ResourceWithConfigure); ok {
+		var configureResp resource.ConfigureResponse
+		withConfigure.Configure(ctx, resource.ConfigureRequest{ProviderData: r.client}, &configureResp)
+		result.Diagnostics.Append(configureResp.Diagnostics...)
+	}
+
+	state := tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw}
+	for name, value := range attrs {
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	readResp := resource.ReadResponse{State: state, Identity: result.Identity}
+	res.Read(ctx, resource.ReadRequest{State: state, Identity: result.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	result.Resource.Raw = readResp.State.Raw
+}
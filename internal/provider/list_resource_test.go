@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestProvider_HasListResources verifies every list resource lists a managed
+// resource of the same type name.
+func TestProvider_HasListResources(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	managed := map[string]bool{}
+	for _, f := range p.Resources(ctx) {
+		var resp resource.MetadataResponse
+		f().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "portkey"}, &resp)
+		managed[resp.TypeName] = true
+	}
+
+	listResources := p.(provider.ProviderWithListResources).ListResources(ctx)
+	if len(listResources) != 11 {
+		t.Errorf("Expected 11 list resources, got %d", len(listResources))
+	}
+	for _, f := range listResources {
+		var resp resource.MetadataResponse
+		f().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "portkey"}, &resp)
+		if !managed[resp.TypeName] {
+			t.Errorf("list resource %s has no managed resource", resp.TypeName)
+		}
+	}
+}
+
+func TestWorkspaceListResource_List(t *testing.T) {
+	ctx := context.Background()
+	c := newWorkspaceTestClient(t)
+
+	lr := NewWorkspaceListResource()
+	lr.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &resource.ConfigureResponse{})
+
+	var configSchema list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	var resourceSchema resource.SchemaResponse
+	NewWorkspaceResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	NewWorkspaceResource().(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	config := func(name interface{}) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: configSchema.Schema,
+			Raw: tftypes.NewValue(configSchema.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, name),
+				"slug": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+	}
+
+	for _, tc := range []struct {
+		name            string
+		pattern         interface{}
+		limit           int64
+		includeResource bool
+		wantIDs         []string
+	}{
+		{"all", nil, 0, false, []string{"ws-uuid-1", "ws-uuid-2"}},
+		{"name pattern", "Prod*", 0, true, []string{"ws-uuid-1"}},
+		{"limit", nil, 1, false, []string{"ws-uuid-1"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := list.ListRequest{
+				Config:                 config(tc.pattern),
+				IncludeResource:        tc.includeResource,
+				Limit:                  tc.limit,
+				ResourceSchema:         resourceSchema.Schema,
+				ResourceIdentitySchema: identitySchema.IdentitySchema,
+			}
+			stream := &list.ListResultsStream{}
+			lr.List(ctx, req, stream)
+
+			var ids []string
+			for result := range stream.Results {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+				}
+				var id types.String
+				result.Diagnostics.Append(result.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+				ids = append(ids, id.ValueString())
+
+				if !tc.includeResource {
+					continue
+				}
+				var name types.String
+				result.Diagnostics.Append(result.Resource.GetAttribute(ctx, path.Root("name"), &name)...)
+				if name.ValueString() != result.DisplayName {
+					t.Errorf("resource name = %s, want %q", name, result.DisplayName)
+				}
+			}
+
+			if len(ids) != len(tc.wantIDs) {
+				t.Fatalf("listed %v, want %v", ids, tc.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tc.wantIDs[i] {
+					t.Errorf("listed %v, want %v", ids, tc.wantIDs)
+				}
+			}
+		})
+	}
+}
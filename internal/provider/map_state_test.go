@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -329,3 +330,86 @@ func TestMapPromptToState_VersionDescriptionNotImported(t *testing.T) {
 		t.Errorf("expected version_description to remain null, got %q", state.VersionDescription.ValueString())
 	}
 }
+
+func TestMapPromptToState_ToolsFormattingPreserved(t *testing.T) {
+	r := &promptResource{}
+
+	userTools := "[\n  {\"type\": \"function\", \"function\": {\"name\": \"lookup\"}}\n]"
+	state := &promptResourceModel{
+		Template:      types.StringValue("template"),
+		Model:         types.StringValue("gpt-4"),
+		PromptVersion: types.Int64Value(1),
+		Parameters:    types.StringValue(`{}`),
+		Tools:         types.StringValue(userTools),
+		ToolChoice:    types.StringValue(`"auto"`),
+		IsRawTemplate: types.BoolValue(false),
+	}
+
+	// Console edit bumped the version but kept the same tools.
+	prompt := &client.Prompt{
+		ID:            "id-1",
+		Slug:          "my-prompt",
+		String:        "template",
+		Model:         "gpt-4",
+		PromptVersion: 2,
+		Tools: []interface{}{
+			map[string]interface{}{"type": "function", "function": map[string]interface{}{"name": "lookup"}},
+		},
+		ToolChoice: "required",
+		CreatedAt:  time.Now(),
+	}
+
+	r.mapPromptToState(state, prompt)
+
+	if state.Tools.ValueString() != userTools {
+		t.Errorf("expected tools formatting to be preserved, got %q", state.Tools.ValueString())
+	}
+	if state.ToolChoice.ValueString() != `"required"` {
+		t.Errorf("expected tool_choice to be refreshed from API, got %q", state.ToolChoice.ValueString())
+	}
+	if !state.Functions.IsNull() {
+		t.Errorf("expected functions to remain null, got %q", state.Functions.ValueString())
+	}
+}
+
+func TestMapPromptToState_MessagesRederivedOnExternalChange(t *testing.T) {
+	r := &promptResource{}
+
+	messages := promptMessagesFromTemplate(`[{"role":"system","content":"be brief"}]`)
+	state := &promptResourceModel{
+		Template:      types.StringValue(`[{"role":"system","content":"be brief"}]`),
+		Messages:      messages,
+		Model:         types.StringValue("gpt-4"),
+		PromptVersion: types.Int64Value(1),
+		Parameters:    types.StringValue(`{}`),
+	}
+
+	prompt := &client.Prompt{
+		ID:            "id-1",
+		Slug:          "my-prompt",
+		String:        `[{"role":"system","content":"be verbose"},{"role":"user","content":"{{q}}"}]`,
+		Model:         "gpt-4",
+		PromptVersion: 2,
+		CreatedAt:     time.Now(),
+	}
+
+	r.mapPromptToState(state, prompt)
+
+	if len(state.Messages.Elements()) != 2 {
+		t.Fatalf("expected 2 messages after external change, got %d", len(state.Messages.Elements()))
+	}
+
+	rendered, diags := renderPromptMessages(context.Background(), state.Messages)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rendered != prompt.String {
+		t.Errorf("expected messages to round-trip to %q, got %q", prompt.String, rendered)
+	}
+}
+
+func TestPromptMessagesFromTemplate_PlainString(t *testing.T) {
+	if got := promptMessagesFromTemplate("Hello {{name}}"); !got.IsNull() {
+		t.Errorf("expected null messages for a plain template, got %v", got)
+	}
+}
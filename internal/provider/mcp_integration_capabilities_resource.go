@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +23,7 @@ var (
 	_ resource.Resource                = &mcpIntegrationCapabilitiesResource{}
 	_ resource.ResourceWithConfigure   = &mcpIntegrationCapabilitiesResource{}
 	_ resource.ResourceWithImportState = &mcpIntegrationCapabilitiesResource{}
+	_ resource.ResourceWithIdentity    = &mcpIntegrationCapabilitiesResource{}
 )
 
 // NewMcpIntegrationCapabilitiesResource is a helper function to simplify the provider implementation.
@@ -101,6 +103,15 @@ Capabilities represent the tools, resources, and prompts exposed by an MCP serve
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *mcpIntegrationCapabilitiesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mcp_integration_id": identityAttribute("MCP integration identifier."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *mcpIntegrationCapabilitiesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -150,6 +161,7 @@ func (r *mcpIntegrationCapabilitiesResource) Create(ctx context.Context, req res
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -173,6 +185,7 @@ func (r *mcpIntegrationCapabilitiesResource) Read(ctx context.Context, req resou
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -203,6 +216,7 @@ func (r *mcpIntegrationCapabilitiesResource) Update(ctx context.Context, req res
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -241,8 +255,10 @@ func (r *mcpIntegrationCapabilitiesResource) Delete(ctx context.Context, req res
 
 // ImportState imports the resource state.
 func (r *mcpIntegrationCapabilitiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mcp_integration_id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics, "mcp_integration_id")
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mcp_integration_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // readCapabilities reads capabilities from the API and filters to only those managed in state.
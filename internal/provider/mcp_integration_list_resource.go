@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewMcpIntegrationListResource lists MCP integrations for `terraform query`.
+func NewMcpIntegrationListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.McpIntegration]{
+		typeName:       "mcp_integration",
+		kind:           "MCP integrations",
+		title:          "MCP Integrations",
+		workspaceScope: listWorkspaceOptional,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.McpIntegration, error) {
+			return c.ListMcpIntegrations(ctx, workspaceID)
+		},
+		fields: map[string]listResourceField[client.McpIntegration]{
+			"name":   {"name", func(m client.McpIntegration) string { return m.Name }},
+			"slug":   {"slug", func(m client.McpIntegration) string { return m.Slug }},
+			"status": {"status", func(m client.McpIntegration) string { return m.Status }},
+		},
+		identity: func(m client.McpIntegration, _ string) map[string]string {
+			return map[string]string{"id": m.ID}
+		},
+		displayName: func(m client.McpIntegration) string { return m.Name },
+		resource:    NewMcpIntegrationResource,
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +23,8 @@ var (
 	_ resource.Resource                = &mcpIntegrationResource{}
 	_ resource.ResourceWithConfigure   = &mcpIntegrationResource{}
 	_ resource.ResourceWithImportState = &mcpIntegrationResource{}
+	_ resource.ResourceWithModifyPlan  = &mcpIntegrationResource{}
+	_ resource.ResourceWithIdentity    = &mcpIntegrationResource{}
 )
 
 // NewMcpIntegrationResource is a helper function to simplify the provider implementation.
@@ -45,6 +48,7 @@ type mcpIntegrationResourceModel struct {
 	Transport      types.String `tfsdk:"transport"`
 	Configurations types.String `tfsdk:"configurations"`
 	WorkspaceID    types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug  types.String `tfsdk:"workspace_slug"`
 	Type           types.String `tfsdk:"type"`
 	Status         types.String `tfsdk:"status"`
 	OwnerID        types.String `tfsdk:"owner_id"`
@@ -117,9 +121,9 @@ MCP integrations define an MCP server in the organization's registry, specifying
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"type": schema.StringAttribute{
 				Description: "Integration type.",
 				Computed:    true,
@@ -156,6 +160,15 @@ MCP integrations define an MCP server in the organization's registry, specifying
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *mcpIntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("MCP integration identifier."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *mcpIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -174,6 +187,12 @@ func (r *mcpIntegrationResource) Configure(_ context.Context, req resource.Confi
 	r.client = c
 }
 
+// ModifyPlan replaces the MCP integration only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *mcpIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *mcpIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan mcpIntegrationResourceModel
@@ -234,6 +253,8 @@ func (r *mcpIntegrationResource) Create(ctx context.Context, req resource.Create
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -270,6 +291,8 @@ func (r *mcpIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -323,6 +346,8 @@ func (r *mcpIntegrationResource) Update(ctx context.Context, req resource.Update
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -349,7 +374,7 @@ func (r *mcpIntegrationResource) Delete(ctx context.Context, req resource.Delete
 
 // ImportState imports the resource state.
 func (r *mcpIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // mapMcpIntegrationToState maps a client McpIntegration to the resource model
@@ -367,10 +392,14 @@ func mapMcpIntegrationToState(integration *client.McpIntegration, state *mcpInte
 		state.Description = types.StringNull()
 	}
 
-	if integration.WorkspaceID != "" {
-		state.WorkspaceID = types.StringValue(integration.WorkspaceID)
-	} else {
-		state.WorkspaceID = types.StringNull()
+	// Preserve a configured workspace_id: the API returns the UUID even when
+	// the workspace was given by slug.
+	if state.WorkspaceID.IsNull() || state.WorkspaceID.IsUnknown() {
+		if integration.WorkspaceID != "" {
+			state.WorkspaceID = types.StringValue(integration.WorkspaceID)
+		} else {
+			state.WorkspaceID = types.StringNull()
+		}
 	}
 
 	if integration.Type != "" {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +21,8 @@ var (
 	_ resource.Resource                = &mcpIntegrationWorkspaceAccessResource{}
 	_ resource.ResourceWithConfigure   = &mcpIntegrationWorkspaceAccessResource{}
 	_ resource.ResourceWithImportState = &mcpIntegrationWorkspaceAccessResource{}
+	_ resource.ResourceWithIdentity    = &mcpIntegrationWorkspaceAccessResource{}
+	_ resource.ResourceWithModifyPlan  = &mcpIntegrationWorkspaceAccessResource{}
 )
 
 // NewMcpIntegrationWorkspaceAccessResource is a helper function to simplify the provider implementation.
@@ -37,6 +40,7 @@ type mcpIntegrationWorkspaceAccessResourceModel struct {
 	ID               types.String `tfsdk:"id"`
 	McpIntegrationID types.String `tfsdk:"mcp_integration_id"`
 	WorkspaceID      types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug    types.String `tfsdk:"workspace_slug"`
 	Enabled          types.Bool   `tfsdk:"enabled"`
 }
 
@@ -67,10 +71,8 @@ func (r *mcpIntegrationWorkspaceAccessResource) Schema(_ context.Context, _ reso
 			"workspace_id": schema.StringAttribute{
 				Description: "The workspace ID to grant access to.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"enabled": schema.BoolAttribute{
 				Description: "Whether the MCP integration is enabled for this workspace. Defaults to true.",
 				Optional:    true,
@@ -81,6 +83,16 @@ func (r *mcpIntegrationWorkspaceAccessResource) Schema(_ context.Context, _ reso
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *mcpIntegrationWorkspaceAccessResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"mcp_integration_id": identityAttribute("MCP integration identifier."),
+			"workspace_id":       identityAttribute("Workspace identifier (UUID)."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *mcpIntegrationWorkspaceAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -99,6 +111,12 @@ func (r *mcpIntegrationWorkspaceAccessResource) Configure(_ context.Context, req
 	r.client = c
 }
 
+// ModifyPlan replaces the access grant only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *mcpIntegrationWorkspaceAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *mcpIntegrationWorkspaceAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan mcpIntegrationWorkspaceAccessResourceModel
@@ -138,6 +156,8 @@ func (r *mcpIntegrationWorkspaceAccessResource) Create(ctx context.Context, req
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -169,6 +189,8 @@ func (r *mcpIntegrationWorkspaceAccessResource) Read(ctx context.Context, req re
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -208,6 +230,8 @@ func (r *mcpIntegrationWorkspaceAccessResource) Update(ctx context.Context, req
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -259,7 +283,9 @@ func (r *mcpIntegrationWorkspaceAccessResource) Delete(ctx context.Context, req
 
 // ImportState imports the resource state.
 func (r *mcpIntegrationWorkspaceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics, "mcp_integration_id", "workspace_id")
+
+	parts := strings.Split(id, "/")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid import ID",
@@ -268,7 +294,12 @@ func (r *mcpIntegrationWorkspaceAccessResource) ImportState(ctx context.Context,
 		return
 	}
 
+	checkImportedWorkspace(ctx, r.client, parts[1], &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mcp_integration_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
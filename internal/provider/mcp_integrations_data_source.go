@@ -31,6 +31,9 @@ type mcpIntegrationsDataSourceModel struct {
 	ID           types.String              `tfsdk:"id"`
 	WorkspaceID  types.String              `tfsdk:"workspace_id"`
 	Integrations []mcpIntegrationListModel `tfsdk:"integrations"`
+	Filter       []listFilterModel         `tfsdk:"filter"`
+	IDs          types.List                `tfsdk:"ids"`
+	Slugs        types.List                `tfsdk:"slugs"`
 }
 
 // mcpIntegrationListModel maps integration data in the list
@@ -85,6 +88,11 @@ func (d *mcpIntegrationsDataSource) Schema(_ context.Context, _ datasource.Schem
 					},
 				},
 			},
+			"ids":   listIDsAttribute("MCP integrations"),
+			"slugs": listSlugsAttribute("MCP integrations"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("MCP integrations"),
 		},
 	}
 }
@@ -154,6 +162,13 @@ func (d *mcpIntegrationsDataSource) Read(ctx context.Context, req datasource.Rea
 		state.ID = types.StringValue("all")
 	}
 
+	state.Integrations = applyListFilters(state.Integrations, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Integrations, "id")
+	state.Slugs = listItemStrings(state.Integrations, "slug")
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
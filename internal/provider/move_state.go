@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Moving state across resource types
+//
+// A `moved {}` block whose source is another resource type reaches the
+// target's MoveState. The movers below work like an import: they derive the
+// target's import ID from the source state, run the target's ImportState, and
+// leave the rest of the state to the Read that Terraform runs after the move.
+
+// moveStateSource is a resource type whose state can be moved into a Portkey
+// resource. importID derives the target's import ID from the attributes of
+// the source state.
+type moveStateSource struct {
+	typeName string
+	importID func(attrs map[string]interface{}) (string, error)
+}
+
+// importStateMovers returns a state mover for each source that imports the
+// object the source state refers to into target.
+func importStateMovers(target resource.ResourceWithImportState, sources ...moveStateSource) []resource.StateMover {
+	movers := make([]resource.StateMover, 0, len(sources))
+	for _, source := range sources {
+		movers = append(movers, resource.StateMover{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != source.typeName || req.SourceRawState == nil {
+					return
+				}
+
+				var attrs map[string]interface{}
+				if err := json.Unmarshal(req.SourceRawState.JSON, &attrs); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("Could not decode the state of the %s source: %s", req.SourceTypeName, err),
+					)
+					return
+				}
+				id, err := source.importID(attrs)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("Could not move the state of the %s source: %s", req.SourceTypeName, err),
+					)
+					return
+				}
+
+				importResp := resource.ImportStateResponse{State: resp.TargetState, Identity: resp.TargetIdentity}
+				target.ImportState(ctx, resource.ImportStateRequest{ID: id}, &importResp)
+				resp.Diagnostics.Append(importResp.Diagnostics...)
+				resp.TargetState = importResp.State
+			},
+		})
+	}
+	return movers
+}
+
+// stateString returns the first non-empty string among the named attributes.
+func stateString(attrs map[string]interface{}, names ...string) string {
+	for _, name := range names {
+		if s, ok := attrs[name].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// attributeSource accepts a resource type, such as the same type from a
+// community provider or an earlier release, whose state holds the target's
+// identifying attributes under the same names. Import IDs are built by
+// format from the first non-empty attribute in each group.
+func attributeSource(typeName, format string, groups ...[]string) moveStateSource {
+	return moveStateSource{
+		typeName: typeName,
+		importID: func(attrs map[string]interface{}) (string, error) {
+			values := make([]interface{}, len(groups))
+			for i, names := range groups {
+				value := stateString(attrs, names...)
+				if value == "" {
+					return "", fmt.Errorf("the source state has no %s", strings.Join(names, " or "))
+				}
+				values[i] = value
+			}
+			return fmt.Sprintf(format, values...), nil
+		},
+	}
+}
+
+// restapiObjectSource accepts restapi_object resources of the Mastercard/restapi
+// provider whose path is apiPath, optionally prefixed with /v1 when the
+// provider uri does not include it. importID receives the object ID and the
+// object the API returned.
+func restapiObjectSource(apiPath string, importID func(id string, object map[string]interface{}) (string, error)) moveStateSource {
+	pathPattern := regexp.MustCompile(`^(/v1)?` + apiPath + `/?$`)
+	return moveStateSource{
+		typeName: "restapi_object",
+		importID: func(attrs map[string]interface{}) (string, error) {
+			objectPath := stateString(attrs, "path")
+			if !pathPattern.MatchString(objectPath) {
+				return "", fmt.Errorf("path %q is not a path this resource manages", objectPath)
+			}
+			id := stateString(attrs, "id")
+			if id == "" {
+				return "", fmt.Errorf("the source state has no id")
+			}
+
+			// api_response holds the object as last read; data is the
+			// configured request body, used when no response was recorded.
+			var object map[string]interface{}
+			for _, name := range []string{"api_response", "data"} {
+				if s := stateString(attrs, name); s != "" && json.Unmarshal([]byte(s), &object) == nil {
+					break
+				}
+			}
+			return importID(id, object)
+		},
+	}
+}
+
+// restapiObjectID uses the restapi_object ID as the import ID.
+func restapiObjectID(id string, _ map[string]interface{}) (string, error) {
+	return id, nil
+}
+
+// restapiObjectSlug uses the slug of the object as the import ID, falling
+// back to the restapi_object ID, which the API also accepts.
+func restapiObjectSlug(id string, object map[string]interface{}) (string, error) {
+	if slug := stateString(object, "slug"); slug != "" {
+		return slug, nil
+	}
+	return id, nil
+}
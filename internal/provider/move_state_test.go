@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// moveState runs the state movers of r, configured with a test client, on a
+// source of type sourceType with the given raw state, as the framework does.
+func moveState(t *testing.T, r resource.Resource, sourceType, rawState string) resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var configureResp resource.ConfigureResponse
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: newWorkspaceTestClient(t)}, &configureResp)
+
+	var schema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schema)
+	resp := resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schema.Schema,
+			Raw:    tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/mastercard/restapi",
+		SourceTypeName:        sourceType,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+	for _, mover := range r.(resource.ResourceWithMoveState).MoveState(ctx) {
+		mover.StateMover(ctx, req, &resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			break
+		}
+	}
+	return resp
+}
+
+func TestMoveState(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name       string
+		target     resource.Resource
+		sourceType string
+		rawState   string
+		want       map[string]string
+	}{
+		{
+			name:       "restapi workspace",
+			target:     NewWorkspaceResource(),
+			sourceType: "restapi_object",
+			rawState:   `{"id":"ws-uuid-1","path":"/admin/workspaces","data":"{\"name\":\"Production\"}"}`,
+			want:       map[string]string{"id": "ws-uuid-1"},
+		},
+		{
+			name:       "restapi provider under /v1",
+			target:     NewProviderResource(),
+			sourceType: "restapi_object",
+			rawState:   `{"id":"prov-1","path":"/v1/providers/","api_response":"{\"id\":\"prov-1\",\"workspace_id\":\"ws-uuid-1\"}"}`,
+			want:       map[string]string{"id": "prov-1", "workspace_id": "ws-uuid-1"},
+		},
+		{
+			name:       "restapi config by slug",
+			target:     NewConfigResource(),
+			sourceType: "restapi_object",
+			rawState:   `{"id":"cfg-1","path":"/configs","api_response":"{\"id\":\"cfg-1\",\"slug\":\"routing\"}"}`,
+			want:       map[string]string{"slug": "routing"},
+		},
+		{
+			name:       "restapi api key",
+			target:     NewAPIKeyResource(),
+			sourceType: "restapi_object",
+			rawState:   `{"id":"key-1","path":"/api-keys/workspace/service"}`,
+			want:       map[string]string{"id": "key-1"},
+		},
+		{
+			name:       "community prompt",
+			target:     NewPromptResource(),
+			sourceType: "portkey_prompt",
+			rawState:   `{"id":"prompt-1","slug":"support","name":"Support"}`,
+			want:       map[string]string{"slug": "support"},
+		},
+		{
+			name:       "workspace member into workspace members",
+			target:     NewWorkspaceMembersResource(),
+			sourceType: "portkey_workspace_member",
+			rawState:   `{"id":"ws-prod/user-1","workspace_id":"ws-prod","user_id":"user-1","role":"admin"}`,
+			want:       map[string]string{"id": "ws-prod", "workspace_id": "ws-prod"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := moveState(t, tc.target, tc.sourceType, tc.rawState)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			for name, want := range tc.want {
+				var got types.String
+				resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root(name), &got)...)
+				if got.ValueString() != want {
+					t.Errorf("%s = %q, want %q", name, got.ValueString(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestMoveState_Rejected(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		target     resource.Resource
+		sourceType string
+		rawState   string
+		wantError  string
+	}{
+		{
+			name:       "restapi object of another type",
+			target:     NewPromptResource(),
+			sourceType: "restapi_object",
+			rawState:   `{"id":"partial-1","path":"/prompts/partials"}`,
+			wantError:  `path "/prompts/partials" is not a path this resource manages`,
+		},
+		{
+			name:       "restapi provider without workspace",
+			target:     NewProviderResource(),
+			sourceType: "restapi_object",
+			rawState:   `{"id":"prov-1","path":"/providers"}`,
+			wantError:  "no workspace_id",
+		},
+		{
+			name:       "community provider without workspace",
+			target:     NewProviderResource(),
+			sourceType: "portkey_provider",
+			rawState:   `{"id":"prov-1"}`,
+			wantError:  "the source state has no workspace_id",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := moveState(t, tc.target, tc.sourceType, tc.rawState)
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("expected an error")
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, tc.wantError) {
+				t.Errorf("error %q does not contain %q", detail, tc.wantError)
+			}
+		})
+	}
+
+	// Unknown source types are left to the framework, which reports that the
+	// resource does not support them.
+	resp := moveState(t, NewWorkspaceResource(), "aws_instance", `{"id":"i-1"}`)
+	if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+		t.Errorf("unexpected move of an unknown source: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// findOneByName returns the single item for which matches reports true. kind
+// names the object in errors (e.g. "workspace"); id identifies the candidates
+// listed when the name is ambiguous.
+func findOneByName[T any](items []T, kind, name string, matches func(T) bool, id func(T) string) (T, error) {
+	var found []T
+	for _, item := range items {
+		if matches(item) {
+			found = append(found, item)
+		}
+	}
+
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		var zero T
+		return zero, fmt.Errorf("no %s named %q was found", kind, name)
+	default:
+		ids := make([]string, 0, len(found))
+		for _, item := range found {
+			ids = append(ids, id(item))
+		}
+		var zero T
+		return zero, fmt.Errorf("%d %ss are named %q (%s); look it up by a unique identifier instead",
+			len(found), kind, name, strings.Join(ids, ", "))
+	}
+}
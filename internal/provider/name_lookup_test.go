@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindOneByName(t *testing.T) {
+	type item struct{ id, name string }
+	items := []item{{"a", "prod"}, {"b", "staging"}, {"c", "staging"}}
+	byName := func(name string) func(item) bool {
+		return func(i item) bool { return i.name == name }
+	}
+	id := func(i item) string { return i.id }
+
+	got, err := findOneByName(items, "workspace", "prod", byName("prod"), id)
+	if err != nil || got.id != "a" {
+		t.Fatalf("expected a single match, got %+v, %v", got, err)
+	}
+
+	_, err = findOneByName(items, "workspace", "dev", byName("dev"), id)
+	if err == nil || !strings.Contains(err.Error(), `no workspace named "dev"`) {
+		t.Errorf("expected a not-found error, got %v", err)
+	}
+
+	_, err = findOneByName(items, "workspace", "staging", byName("staging"), id)
+	if err == nil || !strings.Contains(err.Error(), `2 workspaces are named "staging" (b, c)`) {
+		t.Errorf("expected an ambiguity error listing both IDs, got %v", err)
+	}
+}
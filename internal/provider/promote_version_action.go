@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &promoteVersionAction{}
+	_ action.ActionWithConfigure = &promoteVersionAction{}
+)
+
+// NewPromotePromptVersionAction is a helper function to simplify the provider implementation.
+func NewPromotePromptVersionAction() action.Action {
+	return &promoteVersionAction{typeName: "promote_prompt_version", def: promptDefaultVersion}
+}
+
+// NewPromotePromptPartialVersionAction is a helper function to simplify the provider implementation.
+func NewPromotePromptPartialVersionAction() action.Action {
+	return &promoteVersionAction{typeName: "promote_prompt_partial_version", def: promptPartialDefaultVersion}
+}
+
+// promoteVersionAction makes a version of a versioned object the default
+// version, the one-off counterpart of the defaultVersionResource with the
+// same definition.
+type promoteVersionAction struct {
+	// typeName is the action type name without the provider prefix.
+	typeName string
+	def      defaultVersionResourceDefinition
+	client   *client.Client
+}
+
+// Metadata returns the action type name.
+func (a *promoteVersionAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + a.typeName
+}
+
+// Schema defines the schema for the action.
+func (a *promoteVersionAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Makes a version of a Portkey " + a.def.kind + " the default (live) version. Use it instead of " +
+			"`portkey_" + a.def.typeName + "` when promotion is a one-off step, e.g. after a deployment. Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			a.def.slugAttribute: schema.StringAttribute{
+				Description: "Slug or ID of the " + a.def.kind + ".",
+				Required:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version number to make the default.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *promoteVersionAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke makes the version the default.
+func (a *promoteVersionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var slugValue types.String
+	var versionValue types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(a.def.slugAttribute), &slugValue)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version"), &versionValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := slugValue.ValueString()
+	version := int(versionValue.ValueInt64())
+	if err := a.def.makeDefault(ctx, a.client, slug, version); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Portkey "+a.def.title+" Default Version",
+			fmt.Sprintf("Could not make version %d of %s %s the default: %s", version, a.def.kind, slug, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Made version %d of %s %s the default", version, a.def.kind, slug)})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +21,8 @@ var (
 	_ resource.Resource                = &promptCollectionResource{}
 	_ resource.ResourceWithConfigure   = &promptCollectionResource{}
 	_ resource.ResourceWithImportState = &promptCollectionResource{}
+	_ resource.ResourceWithIdentity    = &promptCollectionResource{}
+	_ resource.ResourceWithModifyPlan  = &promptCollectionResource{}
 )
 
 // NewPromptCollectionResource is a helper function to simplify the provider implementation.
@@ -37,6 +40,7 @@ type promptCollectionResourceModel struct {
 	ID                 types.String `tfsdk:"id"`
 	Name               types.String `tfsdk:"name"`
 	WorkspaceID        types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug      types.String `tfsdk:"workspace_slug"`
 	Slug               types.String `tfsdk:"slug"`
 	ParentCollectionID types.String `tfsdk:"parent_collection_id"`
 	IsDefault          types.Bool   `tfsdk:"is_default"`
@@ -70,12 +74,10 @@ to create hierarchical organization structures.`,
 				Required:    true,
 			},
 			"workspace_id": schema.StringAttribute{
-				Description: "Workspace ID (UUID) where this collection belongs.",
+				Description: "ID or slug of the workspace where this collection belongs.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"slug": schema.StringAttribute{
 				Description: "URL-friendly identifier for the collection. Auto-generated from name.",
 				Computed:    true,
@@ -121,6 +123,15 @@ to create hierarchical organization structures.`,
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *promptCollectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("Prompt collection identifier."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *promptCollectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -141,6 +152,12 @@ func (r *promptCollectionResource) Configure(_ context.Context, req resource.Con
 	r.client = client
 }
 
+// ModifyPlan replaces the collection only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *promptCollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *promptCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -202,6 +219,8 @@ func (r *promptCollectionResource) Create(ctx context.Context, req resource.Crea
 	// Set state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -251,6 +270,8 @@ func (r *promptCollectionResource) Read(ctx context.Context, req resource.ReadRe
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -282,6 +303,8 @@ func (r *promptCollectionResource) Update(ctx context.Context, req resource.Upda
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -312,5 +335,5 @@ func (r *promptCollectionResource) Delete(ctx context.Context, req resource.Dele
 // ImportState imports the resource state.
 func (r *promptCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import by ID
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -31,6 +31,9 @@ type promptCollectionsDataSourceModel struct {
 	ID          types.String            `tfsdk:"id"`
 	WorkspaceID types.String            `tfsdk:"workspace_id"`
 	Collections []promptCollectionModel `tfsdk:"collections"`
+	Filter      []listFilterModel       `tfsdk:"filter"`
+	IDs         types.List              `tfsdk:"ids"`
+	Slugs       types.List              `tfsdk:"slugs"`
 }
 
 // promptCollectionModel maps collection data
@@ -108,6 +111,11 @@ func (d *promptCollectionsDataSource) Schema(_ context.Context, _ datasource.Sch
 					},
 				},
 			},
+			"ids":   listIDsAttribute("collections"),
+			"slugs": listSlugsAttribute("collections"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("collections"),
 		},
 	}
 }
@@ -186,6 +194,13 @@ func (d *promptCollectionsDataSource) Read(ctx context.Context, req datasource.R
 		state.ID = types.StringValue("all")
 	}
 
+	state.Collections = applyListFilters(state.Collections, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Collections, "id")
+	state.Slugs = listItemStrings(state.Collections, "slug")
+
 	// Set state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
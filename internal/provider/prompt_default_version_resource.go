@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// promptDefaultVersion describes how prompt versions are made the default,
+// for the resource and the action that do so.
+var promptDefaultVersion = defaultVersionResourceDefinition{
+	typeName:           "prompt_default_version",
+	kind:               "prompt",
+	title:              "Prompt",
+	objectResource:     "portkey_prompt",
+	edited:             "template",
+	slugAttribute:      "prompt_slug",
+	versionIDAttribute: "prompt_version_id",
+	makeDefault: func(ctx context.Context, c *client.Client, slug string, version int) error {
+		return c.MakePromptVersionDefault(ctx, slug, version)
+	},
+	getVersion: func(ctx context.Context, c *client.Client, slug, version string) (int, string, error) {
+		prompt, err := c.GetPrompt(ctx, slug, version)
+		if err != nil {
+			return 0, "", err
+		}
+		return prompt.PromptVersion, prompt.PromptVersionID, nil
+	},
+}
+
+// NewPromptDefaultVersionResource is a helper function to simplify the provider implementation.
+func NewPromptDefaultVersionResource() resource.Resource {
+	return &defaultVersionResource{def: promptDefaultVersion}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPromptDefaultVersionResource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-default")
+	collectionID := getTestCollectionID()
+	virtualKey := getTestVirtualKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if collectionID == "" {
+				t.Skip("TEST_COLLECTION_ID must be set for prompt tests")
+			}
+			if virtualKey == "" {
+				t.Skip("TEST_VIRTUAL_KEY must be set for prompt tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Version 1 is live
+			{
+				Config: testAccPromptDefaultVersionResourceConfig(rName, collectionID, virtualKey, "Hello {{name}}", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_prompt_default_version.test", "version", "1"),
+					resource.TestCheckResourceAttrSet("portkey_prompt_default_version.test", "prompt_version_id"),
+				),
+			},
+			// Editing the template creates version 2 without promoting it
+			{
+				Config: testAccPromptDefaultVersionResourceConfig(rName, collectionID, virtualKey, "Hi {{name}}", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_prompt.test", "prompt_version", "2"),
+					resource.TestCheckResourceAttr("portkey_prompt_default_version.test", "version", "1"),
+				),
+			},
+			// Roll forward
+			{
+				Config: testAccPromptDefaultVersionResourceConfig(rName, collectionID, virtualKey, "Hi {{name}}", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_prompt_default_version.test", "version", "2"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_versions.test", "default_version", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "portkey_prompt_default_version.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPromptDefaultVersionResourceConfig(name, collectionID, virtualKey, template string, version int) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_prompt" "test" {
+  name          = %[1]q
+  collection_id = %[2]q
+  virtual_key   = %[3]q
+  template      = %[4]q
+  model         = "gpt-4o"
+  parameters    = "{}"
+  make_default  = false
+}
+
+resource "portkey_prompt_default_version" "test" {
+  prompt_slug = portkey_prompt.test.slug
+  version     = %[5]d
+}
+
+data "portkey_prompt_versions" "test" {
+  slug = portkey_prompt_default_version.test.prompt_slug
+}
+`, name, collectionID, virtualKey, template, version)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewPromptListResource lists prompts for `terraform query`.
+func NewPromptListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.Prompt]{
+		typeName:       "prompt",
+		kind:           "prompts",
+		title:          "Prompts",
+		workspaceScope: listWorkspaceOptional,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.Prompt, error) {
+			return c.ListPrompts(ctx, workspaceID, "")
+		},
+		fields: map[string]listResourceField[client.Prompt]{
+			"name":          {"name", func(p client.Prompt) string { return p.Name }},
+			"slug":          {"slug", func(p client.Prompt) string { return p.Slug }},
+			"collection_id": {"collection ID", func(p client.Prompt) string { return p.CollectionID }},
+			"status":        {"status", func(p client.Prompt) string { return p.Status }},
+		},
+		identity: func(p client.Prompt, _ string) map[string]string {
+			return map[string]string{"id": p.ID}
+		},
+		state: func(p client.Prompt) map[string]string {
+			return map[string]string{"slug": p.Slug}
+		},
+		displayName: func(p client.Prompt) string { return p.Name },
+		resource:    NewPromptResource,
+	})
+}
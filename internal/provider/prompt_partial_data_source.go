@@ -3,9 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
@@ -35,6 +38,8 @@ type promptPartialDataSourceModel struct {
 	Version                types.String `tfsdk:"version"`
 	PartialVersion         types.Int64  `tfsdk:"partial_version"`
 	PromptPartialVersionID types.String `tfsdk:"prompt_partial_version_id"`
+	VersionStatus          types.String `tfsdk:"version_status"`
+	VersionDescription     types.String `tfsdk:"version_description"`
 	Status                 types.String `tfsdk:"status"`
 	CreatedAt              types.String `tfsdk:"created_at"`
 	UpdatedAt              types.String `tfsdk:"updated_at"`
@@ -57,6 +62,12 @@ func (d *promptPartialDataSource) Schema(_ context.Context, _ datasource.SchemaR
 			"version": schema.StringAttribute{
 				Description: "Version to retrieve: 'latest', 'default', or a specific version number. Defaults to 'default'.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(latest|default|[1-9][0-9]*)$`),
+						"must be 'latest', 'default', or a positive version number",
+					),
+				},
 			},
 			"id": schema.StringAttribute{
 				Description: "Prompt partial identifier (UUID).",
@@ -78,6 +89,14 @@ func (d *promptPartialDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Description: "Version ID of the prompt partial.",
 				Computed:    true,
 			},
+			"version_status": schema.StringAttribute{
+				Description: "Status of the retrieved version.",
+				Computed:    true,
+			},
+			"version_description": schema.StringAttribute{
+				Description: "Description of the retrieved version, if one was provided.",
+				Computed:    true,
+			},
 			"status": schema.StringAttribute{
 				Description: "Status of the prompt partial (active, archived).",
 				Computed:    true,
@@ -145,6 +164,18 @@ func (d *promptPartialDataSource) Read(ctx context.Context, req datasource.ReadR
 	state.PromptPartialVersionID = types.StringValue(partial.PromptPartialVersionID)
 	state.Status = types.StringValue(partial.Status)
 
+	if partial.PromptPartialVersionStatus != "" {
+		state.VersionStatus = types.StringValue(partial.PromptPartialVersionStatus)
+	} else {
+		state.VersionStatus = types.StringNull()
+	}
+
+	if partial.VersionDescription != "" {
+		state.VersionDescription = types.StringValue(partial.VersionDescription)
+	} else {
+		state.VersionDescription = types.StringNull()
+	}
+
 	state.CreatedAt = types.StringValue(partial.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
 	if !partial.UpdatedAt.IsZero() {
 		state.UpdatedAt = types.StringValue(partial.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
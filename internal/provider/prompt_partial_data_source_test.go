@@ -35,6 +35,30 @@ func TestAccPromptPartialDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccPromptPartialDataSource_version(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-ds-version")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptPartialResourceConfig(rName, "Version 1 content"),
+			},
+			// After an update, version 1 is still readable by number
+			{
+				Config: testAccPromptPartialDataSourceVersionConfig(rName, "Version 2 content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial.v1", "partial_version", "1"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial.v1", "content", "Version 1 content"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial.latest", "partial_version", "2"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial.latest", "content", "Version 2 content"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPromptPartialDataSourceConfig(name string) string {
 	return fmt.Sprintf(`
 provider "portkey" {}
@@ -50,3 +74,17 @@ data "portkey_prompt_partial" "test" {
 }
 `, name, getTestWorkspaceID())
 }
+
+func testAccPromptPartialDataSourceVersionConfig(name, content string) string {
+	return testAccPromptPartialResourceConfig(name, content) + `
+data "portkey_prompt_partial" "v1" {
+  slug    = portkey_prompt_partial.test.slug
+  version = "1"
+}
+
+data "portkey_prompt_partial" "latest" {
+  slug    = portkey_prompt_partial.test.slug
+  version = "latest"
+}
+`
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// promptPartialDefaultVersion describes how prompt partial versions are made
+// the default, for the resource and the action that do so.
+var promptPartialDefaultVersion = defaultVersionResourceDefinition{
+	typeName:           "prompt_partial_default_version",
+	kind:               "prompt partial",
+	title:              "Prompt Partial",
+	objectResource:     "portkey_prompt_partial",
+	edited:             "content",
+	slugAttribute:      "prompt_partial_slug",
+	versionIDAttribute: "prompt_partial_version_id",
+	makeDefault: func(ctx context.Context, c *client.Client, slug string, version int) error {
+		return c.MakePromptPartialVersionDefault(ctx, slug, version)
+	},
+	getVersion: func(ctx context.Context, c *client.Client, slug, version string) (int, string, error) {
+		partial, err := c.GetPromptPartial(ctx, slug, version)
+		if err != nil {
+			return 0, "", err
+		}
+		return partial.Version, partial.PromptPartialVersionID, nil
+	},
+}
+
+// NewPromptPartialDefaultVersionResource is a helper function to simplify the provider implementation.
+func NewPromptPartialDefaultVersionResource() resource.Resource {
+	return &defaultVersionResource{def: promptPartialDefaultVersion}
+}
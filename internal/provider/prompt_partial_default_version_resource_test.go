@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPromptPartialDefaultVersionResource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-partial-default")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Version 1 is live
+			{
+				Config: testAccPromptPartialDefaultVersionResourceConfig(rName, "Version one.", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_prompt_partial_default_version.test", "version", "1"),
+					resource.TestCheckResourceAttrSet("portkey_prompt_partial_default_version.test", "prompt_partial_version_id"),
+				),
+			},
+			// Editing the content creates version 2 without promoting it
+			{
+				Config: testAccPromptPartialDefaultVersionResourceConfig(rName, "Version two.", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_prompt_partial.test", "version", "2"),
+					resource.TestCheckResourceAttr("portkey_prompt_partial_default_version.test", "version", "1"),
+				),
+			},
+			// Roll forward
+			{
+				Config: testAccPromptPartialDefaultVersionResourceConfig(rName, "Version two.", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_prompt_partial_default_version.test", "version", "2"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial_versions.test", "default_version", "2"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial_versions.test", "versions.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "portkey_prompt_partial_default_version.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPromptPartialDefaultVersionResourceConfig(name, content string, version int) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_prompt_partial" "test" {
+  name         = %[1]q
+  content      = %[2]q
+  workspace_id = %[3]q
+  make_default = false
+}
+
+resource "portkey_prompt_partial_default_version" "test" {
+  prompt_partial_slug = portkey_prompt_partial.test.slug
+  version             = %[4]d
+}
+
+data "portkey_prompt_partial_versions" "test" {
+  slug = portkey_prompt_partial_default_version.test.prompt_partial_slug
+}
+`, name, content, getTestWorkspaceID(), version)
+}
@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,6 +23,8 @@ var (
 	_ resource.Resource                = &promptPartialResource{}
 	_ resource.ResourceWithConfigure   = &promptPartialResource{}
 	_ resource.ResourceWithImportState = &promptPartialResource{}
+	_ resource.ResourceWithIdentity    = &promptPartialResource{}
+	_ resource.ResourceWithModifyPlan  = &promptPartialResource{}
 )
 
 // NewPromptPartialResource is a helper function to simplify the provider implementation.
@@ -39,7 +44,9 @@ type promptPartialResourceModel struct {
 	Name                   types.String `tfsdk:"name"`
 	Content                types.String `tfsdk:"content"`
 	WorkspaceID            types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug          types.String `tfsdk:"workspace_slug"`
 	VersionDescription     types.String `tfsdk:"version_description"`
+	MakeDefault            types.Bool   `tfsdk:"make_default"`
 	Version                types.Int64  `tfsdk:"version"`
 	PromptPartialVersionID types.String `tfsdk:"prompt_partial_version_id"`
 	Status                 types.String `tfsdk:"status"`
@@ -82,14 +89,19 @@ func (r *promptPartialResource) Schema(_ context.Context, _ resource.SchemaReque
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID to scope the prompt partial to. Required when using an org-level API key.",
 				Optional:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"version_description": schema.StringAttribute{
 				Description: "Description for the prompt partial version.",
 				Optional:    true,
 			},
+			"make_default": schema.BoolAttribute{
+				Description: "Whether a new version created by an update is made the default (live) version. " +
+					"Set to false when the live version is pinned with portkey_prompt_partial_default_version; the resource then tracks the latest version. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 			"version": schema.Int64Attribute{
 				Description: "Current version number of the prompt partial.",
 				Computed:    true,
@@ -114,6 +126,16 @@ func (r *promptPartialResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *promptPartialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id":           identityAttribute("Prompt partial identifier (UUID)."),
+			"workspace_id": optionalIdentityAttribute("Workspace identifier (UUID)."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *promptPartialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -134,6 +156,12 @@ func (r *promptPartialResource) Configure(_ context.Context, req resource.Config
 	r.client = client
 }
 
+// ModifyPlan replaces the prompt partial only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *promptPartialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *promptPartialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -186,6 +214,8 @@ func (r *promptPartialResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -198,9 +228,37 @@ func (r *promptPartialResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	importedVersion, diags := req.Private.GetKey(ctx, importedVersionPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pick the version to read. After a "slug@version" import keep reading
+	// the imported version until an update creates a new one. With
+	// make_default = false the default may be pinned elsewhere, so track the
+	// latest version instead.
+	version := ""
+	switch {
+	case importedVersion != nil && !state.Version.IsNull() && !state.Version.IsUnknown():
+		version = strconv.FormatInt(state.Version.ValueInt64(), 10)
+	case !state.MakeDefault.IsNull() && !state.MakeDefault.IsUnknown() && !state.MakeDefault.ValueBool():
+		latest, err := r.latestPartialVersion(ctx, state.Slug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Portkey Prompt Partial",
+				"Could not list versions of Portkey prompt partial slug "+state.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if latest > 0 {
+			version = strconv.Itoa(latest)
+		}
+	}
+
 	// Fetch the partial from the API. mapPartialToState detects external
 	// changes by comparing versions and refreshes content if needed.
-	partial, err := r.client.GetPromptPartial(ctx, state.Slug.ValueString(), "")
+	partial, err := r.client.GetPromptPartial(ctx, state.Slug.ValueString(), version)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Portkey Prompt Partial",
@@ -218,6 +276,8 @@ func (r *promptPartialResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -280,8 +340,8 @@ func (r *promptPartialResource) Update(ctx context.Context, req resource.UpdateR
 			return
 		}
 
-		// If a new version was created (content changed), make it the default.
-		// Look up the real version number from the versions list by matching
+		// If a new version was created (content changed), make it the default
+		// unless make_default is false. Look up the real version number from the versions list by matching
 		// the version ID returned by Update, since the MakeDefault endpoint
 		// requires a version number (not a UUID).
 		if contentChanged && updateResp.PromptPartialVersionID != "" {
@@ -310,13 +370,18 @@ func (r *promptPartialResource) Update(ctx context.Context, req resource.UpdateR
 				return
 			}
 
-			err = r.client.MakePromptPartialVersionDefault(ctx, state.Slug.ValueString(), newVersion)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error making prompt partial version default",
-					"Could not make latest version default: "+err.Error(),
-				)
-				return
+			// The imported version is superseded by the new one.
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedVersionPrivateKey, nil)...)
+
+			if plan.MakeDefault.ValueBool() {
+				err = r.client.MakePromptPartialVersionDefault(ctx, state.Slug.ValueString(), newVersion)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error making prompt partial version default",
+						"Could not make latest version default: "+err.Error(),
+					)
+					return
+				}
 			}
 		}
 	}
@@ -342,6 +407,8 @@ func (r *promptPartialResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -366,17 +433,64 @@ func (r *promptPartialResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 // ImportState imports the resource state.
+// Import format: "slug", "slug@version", "workspace_id/slug" or "workspace_id/slug@version"
 func (r *promptPartialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Support both "slug" and "workspace_id/slug" import formats
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) == 2 {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), parts[0])...)
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), parts[1])...)
-	} else {
-		resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	if req.ID == "" {
+		importSlugFromIdentity(ctx, req, resp, "prompt partial", func(id, _ string) (string, error) {
+			partial, err := r.client.GetPromptPartial(ctx, id, "")
+			if err != nil {
+				if client.IsNotFound(err) {
+					return "", nil
+				}
+				return "", err
+			}
+			return partial.Slug, nil
+		})
+		return
+	}
+
+	id := req.ID
+	if workspaceID, rest, ok := strings.Cut(id, "/"); ok {
+		checkImportedWorkspace(ctx, r.client, workspaceID, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+		id = rest
+	}
+
+	slug, version, err := parseSlugAtVersion(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in format: slug, slug@version, workspace_id/slug or workspace_id/slug@version. "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), slug)...)
+	if version > 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), int64(version))...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedVersionPrivateKey, []byte("true"))...)
 	}
 }
 
+// latestPartialVersion returns the highest version number of a prompt
+// partial, or 0 when the partial has no versions.
+func (r *promptPartialResource) latestPartialVersion(ctx context.Context, slug string) (int, error) {
+	versions, err := r.client.ListPromptPartialVersions(ctx, slug)
+	if err != nil {
+		return 0, err
+	}
+	latest := 0
+	for _, v := range versions {
+		if v.Version > latest {
+			latest = v.Version
+		}
+	}
+	return latest, nil
+}
+
 // mapPartialToState maps a PromptPartial API response to the Terraform state model.
 // Detects external changes by comparing API version to state version. If the API
 // version is higher, someone edited outside Terraform and we refresh from the API
@@ -402,6 +516,11 @@ func (r *promptPartialResource) mapPartialToState(state *promptPartialResourceMo
 	state.Version = types.Int64Value(int64(partial.Version))
 	state.PromptPartialVersionID = types.StringValue(partial.PromptPartialVersionID)
 
+	// make_default is not stored by the API; fall back to the schema default on import.
+	if state.MakeDefault.IsNull() || state.MakeDefault.IsUnknown() {
+		state.MakeDefault = types.BoolValue(true)
+	}
+
 	// Preserve workspace_id from state — the API does not return it in the
 	// PromptPartial response, so we must never overwrite the user-supplied value.
 
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &promptPartialVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &promptPartialVersionsDataSource{}
+)
+
+// NewPromptPartialVersionsDataSource is a helper function to simplify the provider implementation.
+func NewPromptPartialVersionsDataSource() datasource.DataSource {
+	return &promptPartialVersionsDataSource{}
+}
+
+// promptPartialVersionsDataSource is the data source implementation.
+type promptPartialVersionsDataSource struct {
+	client *client.Client
+}
+
+// promptPartialVersionsDataSourceModel maps the data source schema data.
+type promptPartialVersionsDataSourceModel struct {
+	Slug           types.String                    `tfsdk:"slug"`
+	DefaultVersion types.Int64                     `tfsdk:"default_version"`
+	Versions       []promptPartialVersionDataModel `tfsdk:"versions"`
+	Filter         []listFilterModel               `tfsdk:"filter"`
+	IDs            types.List                      `tfsdk:"ids"`
+}
+
+// promptPartialVersionDataModel maps a single prompt partial version.
+type promptPartialVersionDataModel struct {
+	Version     types.Int64  `tfsdk:"version"`
+	ID          types.String `tfsdk:"id"`
+	Status      types.String `tfsdk:"status"`
+	Description types.String `tfsdk:"description"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *promptPartialVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt_partial_versions"
+}
+
+// Schema defines the schema for the data source.
+func (d *promptPartialVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all versions of a Portkey prompt partial, newest first, and reports which version is the default.",
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				Description: "The slug or ID of the prompt partial.",
+				Required:    true,
+			},
+			"default_version": schema.Int64Attribute{
+				Description: "Version number currently marked as the default (live) version.",
+				Computed:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "List of prompt partial versions, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.Int64Attribute{
+							Description: "Version number.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Version identifier (UUID).",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the version (active, archived).",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Version description, if one was provided.",
+							Computed:    true,
+						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether this version is the default version.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the version was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ids": listIDsAttribute("versions"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("versions"),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *promptPartialVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *promptPartialVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state promptPartialVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := d.client.ListPromptPartialVersions(ctx, state.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Portkey Prompt Partial Versions",
+			err.Error(),
+		)
+		return
+	}
+
+	// Fetching the partial without a version returns the default version.
+	partial, err := d.client.GetPromptPartial(ctx, state.Slug.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Portkey Prompt Partial",
+			err.Error(),
+		)
+		return
+	}
+
+	state.DefaultVersion = types.Int64Value(int64(partial.Version))
+	state.Versions = make([]promptPartialVersionDataModel, 0, len(versions))
+	for _, v := range versions {
+		item := promptPartialVersionDataModel{
+			Version:   types.Int64Value(int64(v.Version)),
+			ID:        types.StringValue(v.PromptPartialVersionID),
+			IsDefault: types.BoolValue(v.Version == partial.Version),
+		}
+
+		if v.PromptPartialVersionStatus != "" {
+			item.Status = types.StringValue(v.PromptPartialVersionStatus)
+		} else {
+			item.Status = types.StringNull()
+		}
+
+		if v.VersionDescription != "" {
+			item.Description = types.StringValue(v.VersionDescription)
+		} else {
+			item.Description = types.StringNull()
+		}
+
+		if !v.CreatedAt.IsZero() {
+			item.CreatedAt = types.StringValue(v.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+		} else {
+			item.CreatedAt = types.StringNull()
+		}
+
+		state.Versions = append(state.Versions, item)
+	}
+
+	state.Versions = applyListFilters(state.Versions, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Versions, "id")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
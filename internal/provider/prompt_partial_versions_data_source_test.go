@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPromptPartialVersionsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-partial-versions")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptPartialVersionsDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial_versions.test", "default_version", "1"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial_versions.test", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial_versions.test", "versions.0.version", "1"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_partial_versions.test", "versions.0.is_default", "true"),
+					resource.TestCheckResourceAttrSet("data.portkey_prompt_partial_versions.test", "versions.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPromptPartialVersionsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_prompt_partial" "test" {
+  name         = %[1]q
+  content      = "Versions test content."
+  workspace_id = %[2]q
+}
+
+data "portkey_prompt_partial_versions" "test" {
+  slug = portkey_prompt_partial.test.slug
+}
+`, name, getTestWorkspaceID())
+}
@@ -30,6 +30,9 @@ type promptPartialsDataSource struct {
 type promptPartialsDataSourceModel struct {
 	WorkspaceID    types.String                `tfsdk:"workspace_id"`
 	PromptPartials []promptPartialSummaryModel `tfsdk:"prompt_partials"`
+	Filter         []listFilterModel           `tfsdk:"filter"`
+	IDs            types.List                  `tfsdk:"ids"`
+	Slugs          types.List                  `tfsdk:"slugs"`
 }
 
 // promptPartialSummaryModel maps prompt partial summary data.
@@ -88,6 +91,11 @@ func (d *promptPartialsDataSource) Schema(_ context.Context, _ datasource.Schema
 					},
 				},
 			},
+			"ids":   listIDsAttribute("prompt partials"),
+			"slugs": listSlugsAttribute("prompt partials"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("prompt partials"),
 		},
 	}
 }
@@ -151,6 +159,13 @@ func (d *promptPartialsDataSource) Read(ctx context.Context, req datasource.Read
 		state.PromptPartials = append(state.PromptPartials, partialState)
 	}
 
+	state.PromptPartials = applyListFilters(state.PromptPartials, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.PromptPartials, "id")
+	state.Slugs = listItemStrings(state.PromptPartials, "slug")
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -4,24 +4,51 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &promptResource{}
-	_ resource.ResourceWithConfigure   = &promptResource{}
-	_ resource.ResourceWithImportState = &promptResource{}
+	_ resource.Resource                   = &promptResource{}
+	_ resource.ResourceWithConfigure      = &promptResource{}
+	_ resource.ResourceWithImportState    = &promptResource{}
+	_ resource.ResourceWithMoveState      = &promptResource{}
+	_ resource.ResourceWithModifyPlan     = &promptResource{}
+	_ resource.ResourceWithValidateConfig = &promptResource{}
+	_ resource.ResourceWithIdentity       = &promptResource{}
 )
 
+// promptMessageObjectType is the element type of the messages list.
+var promptMessageObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"role":    types.StringType,
+		"content": types.StringType,
+	},
+}
+
+// importedVersionPrivateKey is the private state key marking a prompt or
+// prompt partial imported as "slug@version". Read keeps reading that version,
+// rather than the default or latest one, until an update creates a new
+// version.
+const importedVersionPrivateKey = "imported_version"
+
 // NewPromptResource is a helper function to simplify the provider implementation.
 func NewPromptResource() resource.Resource {
 	return &promptResource{}
@@ -39,9 +66,16 @@ type promptResourceModel struct {
 	Name                types.String `tfsdk:"name"`
 	CollectionID        types.String `tfsdk:"collection_id"`
 	Template            types.String `tfsdk:"template"`
+	Messages            types.List   `tfsdk:"messages"`
 	Parameters          types.String `tfsdk:"parameters"`
 	Model               types.String `tfsdk:"model"`
 	VirtualKey          types.String `tfsdk:"virtual_key"`
+	Tools               types.String `tfsdk:"tools"`
+	ToolChoice          types.String `tfsdk:"tool_choice"`
+	Functions           types.String `tfsdk:"functions"`
+	TemplateMetadata    types.String `tfsdk:"template_metadata"`
+	IsRawTemplate       types.Bool   `tfsdk:"is_raw_template"`
+	MakeDefault         types.Bool   `tfsdk:"make_default"`
 	VersionDescription  types.String `tfsdk:"version_description"`
 	PromptVersion       types.Int64  `tfsdk:"prompt_version"`
 	PromptVersionID     types.String `tfsdk:"prompt_version_id"`
@@ -51,6 +85,18 @@ type promptResourceModel struct {
 	UpdatedAt           types.String `tfsdk:"updated_at"`
 }
 
+// promptMessageModel maps a single entry of the messages list.
+type promptMessageModel struct {
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
+}
+
+// promptMessage is the JSON shape of a chat message inside a prompt template.
+type promptMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
 // Metadata returns the resource type name.
 func (r *promptResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_prompt"
@@ -87,8 +133,35 @@ func (r *promptResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"template": schema.StringAttribute{
-				Description: "Prompt template string. Supports {{variable}} syntax for dynamic content.",
-				Required:    true,
+				Description: "Prompt template string. Supports {{variable}} syntax for dynamic content. " +
+					"Exactly one of template or messages must be set; when messages is used this is computed as the JSON-encoded message list.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("messages")),
+				},
+			},
+			"messages": schema.ListNestedAttribute{
+				Description: "Structured chat messages, as an alternative to a raw template string. Message content supports {{variable}} syntax.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "Message role: 'system', 'developer', 'user', 'assistant' or 'tool'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("system", "developer", "user", "assistant", "tool"),
+							},
+						},
+						"content": schema.StringAttribute{
+							Description: "Message content.",
+							Required:    true,
+						},
+					},
+				},
 			},
 			"parameters": schema.StringAttribute{
 				Description: "JSON string of model parameters (e.g., temperature, max_tokens).",
@@ -103,6 +176,35 @@ func (r *promptResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Virtual key (provider) ID or slug to use for this prompt.",
 				Required:    true,
 			},
+			"tools": schema.StringAttribute{
+				Description: "JSON array of tool definitions available to the model (OpenAI tools format).",
+				Optional:    true,
+			},
+			"tool_choice": schema.StringAttribute{
+				Description: "JSON-encoded tool_choice value, e.g. jsonencode(\"auto\") or a JSON object selecting a specific function.",
+				Optional:    true,
+			},
+			"functions": schema.StringAttribute{
+				Description: "JSON array of legacy function definitions. Prefer tools for new prompts.",
+				Optional:    true,
+			},
+			"template_metadata": schema.StringAttribute{
+				Description: "JSON object of template metadata stored with the prompt version.",
+				Optional:    true,
+			},
+			"is_raw_template": schema.BoolAttribute{
+				Description: "Whether the template is sent to the model as-is without chat message parsing. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"make_default": schema.BoolAttribute{
+				Description: "Whether a new version created by an update is made the default (live) version. " +
+					"Set to false when the live version is pinned with portkey_prompt_default_version; the resource then tracks the latest version. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 			"version_description": schema.StringAttribute{
 				Description: "Description for the prompt version.",
 				Optional:    true,
@@ -135,6 +237,15 @@ func (r *promptResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *promptResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("Prompt identifier (UUID)."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *promptResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -155,6 +266,67 @@ func (r *promptResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ValidateConfig checks that the JSON-valued attributes decode to the shape the
+// API expects.
+func (r *promptResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config promptResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var array []interface{}
+	var object map[string]interface{}
+	var value interface{}
+
+	checks := []struct {
+		name   string
+		value  types.String
+		target interface{}
+		kind   string
+	}{
+		{"tools", config.Tools, &array, "a JSON array"},
+		{"functions", config.Functions, &array, "a JSON array"},
+		{"tool_choice", config.ToolChoice, &value, "valid JSON"},
+		{"template_metadata", config.TemplateMetadata, &object, "a JSON object"},
+	}
+	for _, c := range checks {
+		if c.value.IsNull() || c.value.IsUnknown() {
+			continue
+		}
+		if err := json.Unmarshal([]byte(c.value.ValueString()), c.target); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(c.name),
+				"Invalid "+c.name+" JSON",
+				fmt.Sprintf("The %s attribute must be %s: %s", c.name, c.kind, err.Error()),
+			)
+		}
+	}
+}
+
+// ModifyPlan renders messages into the computed template so that changes to
+// individual messages show up as a template change in the plan.
+func (r *promptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var messages types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("messages"), &messages)...)
+	if resp.Diagnostics.HasError() || messages.IsNull() || messages.IsUnknown() {
+		return
+	}
+
+	template, diags := renderPromptMessages(ctx, messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("template"), types.StringValue(template))...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *promptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -178,13 +350,43 @@ func (r *promptResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create new prompt
+	isRawTemplate := 0
+	if plan.IsRawTemplate.ValueBool() {
+		isRawTemplate = 1
+	}
 	createReq := client.CreatePromptRequest{
-		Name:         plan.Name.ValueString(),
-		CollectionID: plan.CollectionID.ValueString(),
-		String:       plan.Template.ValueString(),
-		Parameters:   parameters,
-		Model:        plan.Model.ValueString(),
-		VirtualKey:   plan.VirtualKey.ValueString(),
+		Name:          plan.Name.ValueString(),
+		CollectionID:  plan.CollectionID.ValueString(),
+		String:        plan.Template.ValueString(),
+		Parameters:    parameters,
+		Model:         plan.Model.ValueString(),
+		VirtualKey:    plan.VirtualKey.ValueString(),
+		IsRawTemplate: &isRawTemplate,
+	}
+
+	// Decode tool definitions and template metadata
+	jsonFields := []struct {
+		name   string
+		value  types.String
+		target interface{}
+	}{
+		{"tools", plan.Tools, &createReq.Tools},
+		{"functions", plan.Functions, &createReq.Functions},
+		{"tool_choice", plan.ToolChoice, &createReq.ToolChoice},
+		{"template_metadata", plan.TemplateMetadata, &createReq.TemplateMetadata},
+	}
+	for _, f := range jsonFields {
+		if f.value.IsNull() || f.value.IsUnknown() {
+			continue
+		}
+		if err := json.Unmarshal([]byte(f.value.ValueString()), f.target); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(f.name),
+				"Invalid "+f.name+" JSON",
+				fmt.Sprintf("The %s attribute must be valid JSON: %s", f.name, err.Error()),
+			)
+			return
+		}
 	}
 
 	if !plan.VersionDescription.IsNull() && !plan.VersionDescription.IsUnknown() {
@@ -219,6 +421,7 @@ func (r *promptResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -231,9 +434,37 @@ func (r *promptResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	importedVersion, diags := req.Private.GetKey(ctx, importedVersionPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pick the version to read. After a "slug@version" import keep reading
+	// the imported version until an update creates a new one. With
+	// make_default = false the default may be pinned elsewhere, so track the
+	// latest version instead.
+	version := ""
+	switch {
+	case importedVersion != nil && !state.PromptVersion.IsNull() && !state.PromptVersion.IsUnknown():
+		version = strconv.FormatInt(state.PromptVersion.ValueInt64(), 10)
+	case !state.MakeDefault.IsNull() && !state.MakeDefault.IsUnknown() && !state.MakeDefault.ValueBool():
+		latest, err := r.latestPromptVersion(ctx, state.Slug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Portkey Prompt",
+				"Could not list versions of Portkey prompt slug "+state.Slug.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if latest > 0 {
+			version = strconv.Itoa(latest)
+		}
+	}
+
 	// Fetch the prompt from the API. mapPromptToState detects external
 	// changes by comparing versions and refreshes content if needed.
-	prompt, err := r.client.GetPrompt(ctx, state.Slug.ValueString(), "")
+	prompt, err := r.client.GetPrompt(ctx, state.Slug.ValueString(), version)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Portkey Prompt",
@@ -266,6 +497,7 @@ func (r *promptResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -293,14 +525,19 @@ func (r *promptResource) Update(ctx context.Context, req resource.UpdateRequest,
 	paramsChanged := plan.Parameters.ValueString() != state.Parameters.ValueString()
 	virtualKeyChanged := plan.VirtualKey.ValueString() != state.VirtualKey.ValueString()
 	versionDescChanged := !plan.VersionDescription.Equal(state.VersionDescription)
+	toolsChanged := !plan.Tools.Equal(state.Tools) ||
+		!plan.ToolChoice.Equal(state.ToolChoice) ||
+		!plan.Functions.Equal(state.Functions)
+	metadataChanged := !plan.TemplateMetadata.Equal(state.TemplateMetadata) ||
+		!plan.IsRawTemplate.Equal(state.IsRawTemplate)
 
-	versionUpdateRequired := templateChanged || modelChanged || paramsChanged
+	versionUpdateRequired := templateChanged || modelChanged || paramsChanged || toolsChanged || metadataChanged
 
 	// Warn if version_description changed without a version-triggering field change — it only takes effect with new versions
 	if versionDescChanged && !versionUpdateRequired {
 		resp.Diagnostics.AddWarning(
 			"Version Description Change Ignored",
-			"The version_description was changed but no version-triggering fields (template, model, or parameters) were modified. Version descriptions are only applied when a new version is created. The version_description change will be stored in state but not sent to the API.",
+			"The version_description was changed but no version-triggering fields (template, messages, model, parameters, tools, or template metadata) were modified. Version descriptions are only applied when a new version is created. The version_description change will be stored in state but not sent to the API.",
 		)
 	}
 
@@ -335,8 +572,17 @@ func (r *promptResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 		// is_raw_template is required for version updates
 		isRawTemplate := 0
+		if plan.IsRawTemplate.ValueBool() {
+			isRawTemplate = 1
+		}
 		updateReq.IsRawTemplate = &isRawTemplate
 
+		// Each version carries its own tool definitions and metadata.
+		updateReq.Tools = promptJSONForUpdate(plan.Tools, state.Tools)
+		updateReq.ToolChoice = promptJSONForUpdate(plan.ToolChoice, state.ToolChoice)
+		updateReq.Functions = promptJSONForUpdate(plan.Functions, state.Functions)
+		updateReq.TemplateMetadata = promptJSONForUpdate(plan.TemplateMetadata, state.TemplateMetadata)
+
 		if !plan.VersionDescription.IsNull() && !plan.VersionDescription.IsUnknown() {
 			updateReq.VersionDescription = plan.VersionDescription.ValueString()
 		}
@@ -389,13 +635,18 @@ func (r *promptResource) Update(ctx context.Context, req resource.UpdateRequest,
 				return
 			}
 
-			err = r.client.MakePromptVersionDefault(ctx, state.Slug.ValueString(), newVersion)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error making prompt version default",
-					"Could not make latest version default: "+err.Error(),
-				)
-				return
+			// The imported version is superseded by the new one.
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedVersionPrivateKey, nil)...)
+
+			if plan.MakeDefault.ValueBool() {
+				err = r.client.MakePromptVersionDefault(ctx, state.Slug.ValueString(), newVersion)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error making prompt version default",
+						"Could not make latest version default: "+err.Error(),
+					)
+					return
+				}
 			}
 		}
 	}
@@ -429,6 +680,7 @@ func (r *promptResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -453,9 +705,60 @@ func (r *promptResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 // ImportState imports the resource state.
+// Import format: "slug" (default version) or "slug@version"
 func (r *promptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by slug
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	if req.ID == "" {
+		importSlugFromIdentity(ctx, req, resp, "prompt", func(id, _ string) (string, error) {
+			prompt, err := r.client.GetPrompt(ctx, id, "")
+			if err != nil {
+				if client.IsNotFound(err) {
+					return "", nil
+				}
+				return "", err
+			}
+			return prompt.Slug, nil
+		})
+		return
+	}
+
+	slug, version, err := parseSlugAtVersion(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in format: slug or slug@version. "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), slug)...)
+	if version > 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prompt_version"), int64(version))...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedVersionPrivateKey, []byte("true"))...)
+	}
+}
+
+// MoveState moves portkey_prompt state from another provider and restapi_object prompts.
+func (r *promptResource) MoveState(_ context.Context) []resource.StateMover {
+	return importStateMovers(r,
+		attributeSource("portkey_prompt", "%s", []string{"slug", "id"}),
+		restapiObjectSource("/prompts", restapiObjectSlug),
+	)
+}
+
+// latestPromptVersion returns the highest version number of a prompt, or 0
+// when the prompt has no versions.
+func (r *promptResource) latestPromptVersion(ctx context.Context, slug string) (int, error) {
+	versions, err := r.client.ListPromptVersions(ctx, slug)
+	if err != nil {
+		return 0, err
+	}
+	latest := 0
+	for _, v := range versions {
+		if v.PromptVersion > latest {
+			latest = v.PromptVersion
+		}
+	}
+	return latest, nil
 }
 
 // mapPromptToState maps a Prompt API response to the Terraform state model.
@@ -479,6 +782,11 @@ func (r *promptResource) mapPromptToState(state *promptResourceModel, prompt *cl
 	if externalChange || state.Template.IsNull() || state.Template.IsUnknown() {
 		state.Template = types.StringValue(prompt.String)
 	}
+	// Messages are only tracked when the user manages the prompt through the
+	// messages attribute; re-derive them from the template on external change.
+	if externalChange && !state.Messages.IsNull() {
+		state.Messages = promptMessagesFromTemplate(prompt.String)
+	}
 	if externalChange || state.Model.IsNull() || state.Model.IsUnknown() {
 		state.Model = types.StringValue(prompt.Model)
 	}
@@ -507,6 +815,28 @@ func (r *promptResource) mapPromptToState(state *promptResourceModel, prompt *cl
 		}
 	}
 
+	// Tool definitions and template metadata follow the same rule, keeping the
+	// user's JSON formatting when the API value is semantically equal.
+	if externalChange || state.Tools.IsNull() {
+		state.Tools = jsonValueToString(state.Tools, prompt.Tools)
+	}
+	if externalChange || state.ToolChoice.IsNull() {
+		state.ToolChoice = jsonValueToString(state.ToolChoice, prompt.ToolChoice)
+	}
+	if externalChange || state.Functions.IsNull() {
+		state.Functions = jsonValueToString(state.Functions, prompt.Functions)
+	}
+	if externalChange || state.TemplateMetadata.IsNull() {
+		state.TemplateMetadata = jsonObjectToString(state.TemplateMetadata, prompt.TemplateMetadata)
+	}
+	if externalChange || state.IsRawTemplate.IsNull() || state.IsRawTemplate.IsUnknown() {
+		state.IsRawTemplate = types.BoolValue(prompt.IsRawTemplate == 1)
+	}
+	// make_default is not stored by the API; fall back to the schema default on import.
+	if state.MakeDefault.IsNull() || state.MakeDefault.IsUnknown() {
+		state.MakeDefault = types.BoolValue(true)
+	}
+
 	// Only preserve version_description from state — never import it from the API.
 	// The API may return a version_description set via console edits, but if the
 	// Terraform config doesn't set it, importing it would cause perpetual drift
@@ -517,3 +847,88 @@ func (r *promptResource) mapPromptToState(state *promptResourceModel, prompt *cl
 		state.UpdatedAt = types.StringValue(prompt.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
 	}
 }
+
+// renderPromptMessages encodes the messages list as the JSON template string
+// stored by the API for chat prompts.
+func renderPromptMessages(ctx context.Context, list types.List) (string, diag.Diagnostics) {
+	var models []promptMessageModel
+	diags := list.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	messages := make([]promptMessage, 0, len(models))
+	for _, m := range models {
+		messages = append(messages, promptMessage{
+			Role:    m.Role.ValueString(),
+			Content: m.Content.ValueString(),
+		})
+	}
+
+	data, err := json.Marshal(messages)
+	if err != nil {
+		diags.AddError("Error Encoding Prompt Messages", "Could not encode messages: "+err.Error())
+		return "", diags
+	}
+	return string(data), diags
+}
+
+// promptMessagesFromTemplate decodes a chat template back into a messages
+// list. Templates that are not a JSON message array map to null, which shows
+// up as drift against a configured messages attribute.
+func promptMessagesFromTemplate(template string) types.List {
+	var messages []promptMessage
+	if err := json.Unmarshal([]byte(template), &messages); err != nil || len(messages) == 0 {
+		return types.ListNull(promptMessageObjectType)
+	}
+
+	elems := make([]attr.Value, 0, len(messages))
+	for _, m := range messages {
+		elems = append(elems, types.ObjectValueMust(promptMessageObjectType.AttrTypes, map[string]attr.Value{
+			"role":    types.StringValue(m.Role),
+			"content": types.StringValue(m.Content),
+		}))
+	}
+	return types.ListValueMust(promptMessageObjectType, elems)
+}
+
+// promptJSONForUpdate converts a JSON string attribute into the three-state
+// json.RawMessage used by UpdatePromptRequest: the compacted value when set,
+// JSONNull when it was removed, and nil when it was never set.
+func promptJSONForUpdate(planned, prior types.String) json.RawMessage {
+	if planned.IsUnknown() {
+		return nil
+	}
+	if planned.IsNull() {
+		if prior.IsNull() {
+			return nil
+		}
+		return client.JSONNull
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(planned.ValueString()), &value); err != nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// parseSlugAtVersion splits an import ID of the form "slug" or "slug@version".
+// A missing version is returned as 0.
+func parseSlugAtVersion(id string) (string, int, error) {
+	slug, versionStr, hasVersion := strings.Cut(id, "@")
+	if slug == "" {
+		return "", 0, fmt.Errorf("slug must not be empty")
+	}
+	if !hasVersion {
+		return slug, 0, nil
+	}
+	version, err := strconv.Atoi(versionStr)
+	if err != nil || version < 1 {
+		return "", 0, fmt.Errorf("version must be a positive integer, got %q", versionStr)
+	}
+	return slug, version, nil
+}
@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -99,6 +105,81 @@ func TestAccPromptResource_updateName(t *testing.T) {
 	})
 }
 
+func TestAccPromptResource_chatMessages(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-chat")
+	collectionID := getTestCollectionID()
+	virtualKey := getTestVirtualKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if collectionID == "" {
+				t.Skip("TEST_COLLECTION_ID must be set for prompt tests")
+			}
+			if virtualKey == "" {
+				t.Skip("TEST_VIRTUAL_KEY must be set for prompt tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptResourceConfigChat(rName, collectionID, virtualKey, "You are a helpful assistant.", "auto"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_prompt.test", "messages.#", "2"),
+					resource.TestCheckResourceAttr("portkey_prompt.test", "messages.0.role", "system"),
+					resource.TestCheckResourceAttr("portkey_prompt.test", "template", `[{"role":"system","content":"You are a helpful assistant."},{"role":"user","content":"{{question}}"}]`),
+					resource.TestCheckResourceAttrSet("portkey_prompt.test", "tools"),
+					resource.TestCheckResourceAttr("portkey_prompt.test", "tool_choice", `"auto"`),
+					resource.TestCheckResourceAttr("portkey_prompt.test", "is_raw_template", "false"),
+					resource.TestCheckResourceAttr("portkey_prompt.test", "prompt_version", "1"),
+				),
+			},
+			// Changing a message or tool_choice creates a new version
+			{
+				Config: testAccPromptResourceConfigChat(rName, collectionID, virtualKey, "You are a terse assistant.", "required"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_prompt.test", "messages.0.content", "You are a terse assistant."),
+					resource.TestCheckResourceAttr("portkey_prompt.test", "tool_choice", `"required"`),
+					resource.TestCheckResourceAttr("portkey_prompt.test", "prompt_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPromptResourceConfigChat(name, collectionID, virtualKey, system, toolChoice string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_prompt" "test" {
+  name          = %[1]q
+  collection_id = %[2]q
+  virtual_key   = %[3]q
+  model         = "gpt-4o"
+  parameters    = "{}"
+
+  messages = [
+    { role = "system", content = %[4]q },
+    { role = "user", content = "{{question}}" },
+  ]
+
+  tools = jsonencode([{
+    type = "function"
+    function = {
+      name        = "get_weather"
+      description = "Look up the weather for a city"
+      parameters = {
+        type       = "object"
+        properties = { city = { type = "string" } }
+        required   = ["city"]
+      }
+    }
+  }])
+  tool_choice = jsonencode(%[5]q)
+}
+`, name, collectionID, virtualKey, system, toolChoice)
+}
+
 func testAccPromptResourceConfig(name, collectionID, virtualKey, template, model string) string {
 	return fmt.Sprintf(`
 provider "portkey" {}
@@ -113,3 +194,118 @@ resource "portkey_prompt" "test" {
 }
 `, name, collectionID, virtualKey, template, model)
 }
+
+// TestPromptResource_importedVersionSurvivesRefresh imports a non-default
+// version and refreshes twice; both refreshes must keep reading that version
+// rather than switching to the default one.
+func TestPromptResource_importedVersionSurvivesRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	var versionsRead []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/prompts/my-prompt" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		version := r.URL.Query().Get("version")
+		versionsRead = append(versionsRead, version)
+		if version == "2" {
+			_, _ = w.Write([]byte(`{"id":"p-1","slug":"my-prompt","name":"My Prompt","string":"v2 template","model":"gpt-4","prompt_version":2,"status":"active","created_at":"2025-01-01T00:00:00Z"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"p-1","slug":"my-prompt","name":"My Prompt","string":"v3 template","model":"gpt-4","prompt_version":3,"status":"active","created_at":"2025-01-01T00:00:00Z"}`))
+	}))
+	defer srv.Close()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("NewProtocol6WithError: %v", err)
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: objectValue(t, schemas.Provider.ValueType(), map[string]tftypes.Value{
+			"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+			"base_url": tftypes.NewValue(tftypes.String, srv.URL),
+		}),
+	})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("ConfigureProvider: %v %v", err, configureResp.Diagnostics)
+	}
+
+	importResp, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: "portkey_prompt",
+		ID:       "my-prompt@2",
+	})
+	if err != nil || len(importResp.Diagnostics) > 0 || len(importResp.ImportedResources) != 1 {
+		t.Fatalf("ImportResourceState: %v %v", err, importResp.Diagnostics)
+	}
+
+	promptType := schemas.ResourceSchemas["portkey_prompt"].ValueType()
+	state, private := importResp.ImportedResources[0].State, importResp.ImportedResources[0].Private
+	for i := 1; i <= 2; i++ {
+		readResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+			TypeName:     "portkey_prompt",
+			CurrentState: state,
+			Private:      private,
+		})
+		if err != nil || len(readResp.Diagnostics) > 0 {
+			t.Fatalf("ReadResource %d: %v %v", i, err, readResp.Diagnostics)
+		}
+		state, private = readResp.NewState, readResp.Private
+
+		value, err := state.Unmarshal(promptType)
+		if err != nil {
+			t.Fatalf("Unmarshal state: %v", err)
+		}
+		var attrs map[string]tftypes.Value
+		if err := value.As(&attrs); err != nil {
+			t.Fatalf("state.As: %v", err)
+		}
+		var template string
+		_ = attrs["template"].As(&template)
+		if template != "v2 template" {
+			t.Errorf("refresh %d: template = %q, want the imported version 2", i, template)
+		}
+	}
+
+	if len(versionsRead) != 2 || versionsRead[0] != "2" || versionsRead[1] != "2" {
+		t.Errorf("versions read = %q, want [2 2]", versionsRead)
+	}
+}
+
+func TestParseSlugAtVersion(t *testing.T) {
+	tests := []struct {
+		id          string
+		wantSlug    string
+		wantVersion int
+		wantErr     bool
+	}{
+		{id: "my-prompt", wantSlug: "my-prompt"},
+		{id: "my-prompt@3", wantSlug: "my-prompt", wantVersion: 3},
+		{id: "my-prompt@0", wantErr: true},
+		{id: "my-prompt@latest", wantErr: true},
+		{id: "@2", wantErr: true},
+		{id: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			slug, version, err := parseSlugAtVersion(tt.id)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for %q, got slug=%q version=%d", tt.id, slug, version)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if slug != tt.wantSlug || version != tt.wantVersion {
+				t.Errorf("got (%q, %d), want (%q, %d)", slug, version, tt.wantSlug, tt.wantVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &promptVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &promptVersionsDataSource{}
+)
+
+// NewPromptVersionsDataSource is a helper function to simplify the provider implementation.
+func NewPromptVersionsDataSource() datasource.DataSource {
+	return &promptVersionsDataSource{}
+}
+
+// promptVersionsDataSource is the data source implementation.
+type promptVersionsDataSource struct {
+	client *client.Client
+}
+
+// promptVersionsDataSourceModel maps the data source schema data.
+type promptVersionsDataSourceModel struct {
+	Slug           types.String             `tfsdk:"slug"`
+	DefaultVersion types.Int64              `tfsdk:"default_version"`
+	Versions       []promptVersionDataModel `tfsdk:"versions"`
+	Filter         []listFilterModel        `tfsdk:"filter"`
+	IDs            types.List               `tfsdk:"ids"`
+}
+
+// promptVersionDataModel maps a single prompt version.
+type promptVersionDataModel struct {
+	Version     types.Int64  `tfsdk:"version"`
+	ID          types.String `tfsdk:"id"`
+	Status      types.String `tfsdk:"status"`
+	Description types.String `tfsdk:"description"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *promptVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt_versions"
+}
+
+// Schema defines the schema for the data source.
+func (d *promptVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all versions of a Portkey prompt, newest first, and reports which version is the default.",
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				Description: "The slug or ID of the prompt.",
+				Required:    true,
+			},
+			"default_version": schema.Int64Attribute{
+				Description: "Version number currently marked as the default (live) version.",
+				Computed:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "List of prompt versions, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.Int64Attribute{
+							Description: "Version number.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Version identifier (UUID).",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the version (active, archived).",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Version description, if one was provided.",
+							Computed:    true,
+						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether this version is the default version.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the version was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ids": listIDsAttribute("versions"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("versions"),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *promptVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *promptVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state promptVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := d.client.ListPromptVersions(ctx, state.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Portkey Prompt Versions",
+			err.Error(),
+		)
+		return
+	}
+
+	// Fetching the prompt without a version returns the default version.
+	prompt, err := d.client.GetPrompt(ctx, state.Slug.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Portkey Prompt",
+			err.Error(),
+		)
+		return
+	}
+
+	state.DefaultVersion = types.Int64Value(int64(prompt.PromptVersion))
+	state.Versions = make([]promptVersionDataModel, 0, len(versions))
+	for _, v := range versions {
+		item := promptVersionDataModel{
+			Version:   types.Int64Value(int64(v.PromptVersion)),
+			ID:        types.StringValue(v.ID),
+			IsDefault: types.BoolValue(v.PromptVersion == prompt.PromptVersion),
+		}
+
+		if v.PromptVersionStatus != "" {
+			item.Status = types.StringValue(v.PromptVersionStatus)
+		} else {
+			item.Status = types.StringNull()
+		}
+
+		if v.PromptVersionDescription != "" {
+			item.Description = types.StringValue(v.PromptVersionDescription)
+		} else {
+			item.Description = types.StringNull()
+		}
+
+		if !v.CreatedAt.IsZero() {
+			item.CreatedAt = types.StringValue(v.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+		} else {
+			item.CreatedAt = types.StringNull()
+		}
+
+		state.Versions = append(state.Versions, item)
+	}
+
+	state.Versions = applyListFilters(state.Versions, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Versions, "id")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPromptVersionsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-versions")
+	collectionID := getTestCollectionID()
+	virtualKey := getTestVirtualKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if collectionID == "" {
+				t.Skip("TEST_COLLECTION_ID must be set for prompt tests")
+			}
+			if virtualKey == "" {
+				t.Skip("TEST_VIRTUAL_KEY must be set for prompt tests")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptVersionsDataSourceConfig(rName, collectionID, virtualKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.portkey_prompt_versions.test", "default_version", "1"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_versions.test", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_versions.test", "versions.0.version", "1"),
+					resource.TestCheckResourceAttr("data.portkey_prompt_versions.test", "versions.0.is_default", "true"),
+					resource.TestCheckResourceAttrSet("data.portkey_prompt_versions.test", "versions.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPromptVersionsDataSourceConfig(name, collectionID, virtualKey string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_prompt" "test" {
+  name          = %[1]q
+  collection_id = %[2]q
+  virtual_key   = %[3]q
+  template      = "Hello {{name}}"
+  model         = "gpt-4o"
+  parameters    = "{}"
+}
+
+data "portkey_prompt_versions" "test" {
+  slug = portkey_prompt.test.slug
+}
+`, name, collectionID, virtualKey)
+}
@@ -31,6 +31,9 @@ type promptsDataSourceModel struct {
 	WorkspaceID  types.String         `tfsdk:"workspace_id"`
 	CollectionID types.String         `tfsdk:"collection_id"`
 	Prompts      []promptSummaryModel `tfsdk:"prompts"`
+	Filter       []listFilterModel    `tfsdk:"filter"`
+	IDs          types.List           `tfsdk:"ids"`
+	Slugs        types.List           `tfsdk:"slugs"`
 }
 
 // promptSummaryModel maps prompt summary data.
@@ -103,6 +106,11 @@ func (d *promptsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 				},
 			},
+			"ids":   listIDsAttribute("prompts"),
+			"slugs": listSlugsAttribute("prompts"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("prompts"),
 		},
 	}
 }
@@ -173,6 +181,13 @@ func (d *promptsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		state.Prompts = append(state.Prompts, promptState)
 	}
 
+	state.Prompts = applyListFilters(state.Prompts, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Prompts, "id")
+	state.Slugs = listItemStrings(state.Prompts, "slug")
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
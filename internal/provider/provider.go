@@ -5,7 +5,10 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,7 +19,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &portkeyProvider{}
+	_ provider.Provider                       = &portkeyProvider{}
+	_ provider.ProviderWithListResources      = &portkeyProvider{}
+	_ provider.ProviderWithEphemeralResources = &portkeyProvider{}
+	_ provider.ProviderWithActions            = &portkeyProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -38,9 +44,10 @@ type portkeyProvider struct {
 
 // portkeyProviderModel maps provider schema data to a Go type.
 type portkeyProviderModel struct {
-	APIKey     types.String `tfsdk:"api_key"`
-	BaseURL    types.String `tfsdk:"base_url"`
-	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	APIKey             types.String `tfsdk:"api_key"`
+	BaseURL            types.String `tfsdk:"base_url"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 // Metadata returns the provider type name.
@@ -69,6 +76,12 @@ func (p *portkeyProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 					"Can also be set via the PORTKEY_MAX_RETRIES environment variable.",
 				Optional: true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Default for the `deletion_protection` attribute of workspaces, integrations, secret references, " +
+					"API keys and configs that do not set it. Defaults to false. " +
+					"Can also be set via the PORTKEY_DELETION_PROTECTION environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -113,6 +126,15 @@ func (p *portkeyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.DeletionProtection.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_protection"),
+			"Unknown Portkey Deletion Protection",
+			"The provider cannot be configured as there is an unknown configuration value for deletion_protection. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the PORTKEY_DELETION_PROTECTION environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -139,6 +161,20 @@ func (p *portkeyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
+	var deletionProtection bool
+	if envDeletionProtection := os.Getenv("PORTKEY_DELETION_PROTECTION"); envDeletionProtection != "" {
+		parsed, err := strconv.ParseBool(envDeletionProtection)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deletion_protection"),
+				"Invalid PORTKEY_DELETION_PROTECTION",
+				"PORTKEY_DELETION_PROTECTION must be a boolean. Got: "+envDeletionProtection,
+			)
+		} else {
+			deletionProtection = parsed
+		}
+	}
+
 	if !config.APIKey.IsNull() {
 		apiKey = config.APIKey.ValueString()
 	}
@@ -147,6 +183,10 @@ func (p *portkeyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		baseURL = config.BaseURL.ValueString()
 	}
 
+	if !config.DeletionProtection.IsNull() {
+		deletionProtection = config.DeletionProtection.ValueBool()
+	}
+
 	if !config.MaxRetries.IsNull() {
 		v := config.MaxRetries.ValueInt64()
 		if v < 0 {
@@ -198,10 +238,15 @@ func (p *portkeyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Make the Portkey client available during DataSource and Resource
-	// type Configure methods.
+	client.DeletionProtection = deletionProtection
+
+	// Make the Portkey client available during DataSource, Resource,
+	// ListResource, EphemeralResource and Action type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -211,6 +256,8 @@ func (p *portkeyProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewWorkspacesDataSource,
 		NewUserDataSource,
 		NewUsersDataSource,
+		NewWorkspaceMembersDataSource,
+		NewUserInvitesDataSource,
 		NewIntegrationDataSource,
 		NewIntegrationsDataSource,
 		NewIntegrationWorkspacesDataSource,
@@ -221,10 +268,13 @@ func (p *portkeyProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewProvidersDataSource,
 		NewConfigDataSource,
 		NewConfigsDataSource,
+		NewConfigVersionsDataSource,
 		NewPromptDataSource,
 		NewPromptsDataSource,
+		NewPromptVersionsDataSource,
 		NewPromptPartialDataSource,
 		NewPromptPartialsDataSource,
+		NewPromptPartialVersionsDataSource,
 		NewPromptCollectionDataSource,
 		NewPromptCollectionsDataSource,
 		NewGuardrailDataSource,
@@ -246,7 +296,10 @@ func (p *portkeyProvider) Resources(_ context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		NewWorkspaceResource,
 		NewWorkspaceMemberResource,
+		NewWorkspaceMembersResource,
+		NewUserResource,
 		NewUserInviteResource,
+		NewUserInvitesResource,
 		NewIntegrationResource,
 		NewIntegrationWorkspaceAccessResource,
 		NewIntegrationModelAccessResource,
@@ -254,7 +307,9 @@ func (p *portkeyProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewProviderResource,
 		NewConfigResource,
 		NewPromptResource,
+		NewPromptDefaultVersionResource,
 		NewPromptPartialResource,
+		NewPromptPartialDefaultVersionResource,
 		NewPromptCollectionResource,
 		NewGuardrailResource,
 		NewUsageLimitsPolicyResource,
@@ -266,3 +321,38 @@ func (p *portkeyProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewScimWorkspaceMappingResource,
 	}
 }
+
+// ListResources defines the list resources implemented in the provider, used
+// by `terraform query` to discover existing objects.
+func (p *portkeyProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewWorkspaceListResource,
+		NewIntegrationListResource,
+		NewAPIKeyListResource,
+		NewProviderListResource,
+		NewConfigListResource,
+		NewPromptListResource,
+		NewGuardrailListResource,
+		NewUsageLimitsPolicyListResource,
+		NewRateLimitsPolicyListResource,
+		NewMcpIntegrationListResource,
+		NewSecretReferenceListResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *portkeyProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPIKeyEphemeralResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *portkeyProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRotateAPIKeyAction,
+		NewResetAPIKeyUsageAction,
+		NewPromotePromptVersionAction,
+		NewPromotePromptPartialVersionAction,
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
@@ -35,6 +38,10 @@ type providerDataSourceModel struct {
 	IntegrationID types.String `tfsdk:"integration_id"`
 	AIProviderID  types.String `tfsdk:"ai_provider_id"`
 	Note          types.String `tfsdk:"note"`
+	ModelConfig   types.String `tfsdk:"model_config"`
+	RateLimits    types.List   `tfsdk:"rate_limits"`
+	UsageLimits   types.Object `tfsdk:"usage_limits"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
 	Status        types.String `tfsdk:"status"`
 	CreatedAt     types.String `tfsdk:"created_at"`
 }
@@ -47,11 +54,15 @@ func (d *providerDataSource) Metadata(_ context.Context, req datasource.Metadata
 // Schema defines the schema for the data source.
 func (d *providerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a Portkey Provider by ID. Requires workspace_id.",
+		Description: "Fetches a Portkey Provider by ID or name. Requires workspace_id.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Provider identifier (UUID).",
-				Required:    true,
+				Description: "Provider identifier (UUID). Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID (UUID) where this provider exists.",
@@ -62,8 +73,10 @@ func (d *providerDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Human-readable name for the provider.",
-				Computed:    true,
+				Description: "Human-readable name for the provider. Set it instead of id to look the provider up by name; " +
+					"exactly one provider in the workspace must have this name.",
+				Optional: true,
+				Computed: true,
 			},
 			"integration_id": schema.StringAttribute{
 				Description: "Integration slug or ID used by this provider.",
@@ -77,6 +90,64 @@ func (d *providerDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Note or description for this provider.",
 				Computed:    true,
 			},
+			"model_config": schema.StringAttribute{
+				Description: "JSON object of provider-specific model configuration.",
+				Computed:    true,
+			},
+			"usage_limits": schema.SingleNestedAttribute{
+				Description: "Usage limits for this provider.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"credit_limit": schema.Int64Attribute{
+						Description: "The credit limit value.",
+						Computed:    true,
+					},
+					"alert_threshold": schema.Int64Attribute{
+						Description: "Alert threshold value.",
+						Computed:    true,
+					},
+					"periodic_reset": schema.StringAttribute{
+						Description: "When to reset the usage: 'monthly' or 'weekly'.",
+						Computed:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Usage limit type ('cost' or 'tokens').",
+						Computed:    true,
+					},
+					"next_usage_reset_at": schema.StringAttribute{
+						Description: "Timestamp when the usage counters will next be reset.",
+						Computed:    true,
+					},
+					"periodic_reset_days": schema.Int64Attribute{
+						Description: "Custom reset interval in days (1-365).",
+						Computed:    true,
+					},
+				},
+			},
+			"rate_limits": schema.ListNestedAttribute{
+				Description: "Rate limits for this provider.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of rate limit.",
+							Computed:    true,
+						},
+						"unit": schema.StringAttribute{
+							Description: "Rate limit unit.",
+							Computed:    true,
+						},
+						"value": schema.Int64Attribute{
+							Description: "The rate limit value.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Timestamp when the provider expires, if set.",
+				Computed:    true,
+			},
 			"status": schema.StringAttribute{
 				Description: "Status of the provider (active, archived).",
 				Computed:    true,
@@ -120,8 +191,33 @@ func (d *providerDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	providerID := state.ID.ValueString()
+	if !state.Name.IsNull() {
+		providers, err := d.client.ListProviders(ctx, state.WorkspaceID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Portkey Providers",
+				err.Error(),
+			)
+			return
+		}
+
+		name := state.Name.ValueString()
+		match, err := findOneByName(providers, "provider", name, func(p client.Provider) bool {
+			return p.Name == name
+		}, func(p client.Provider) string { return p.ID })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Find Portkey Provider",
+				err.Error(),
+			)
+			return
+		}
+		providerID = match.ID
+	}
+
 	// Get provider from Portkey
-	provider, err := d.client.GetProvider(ctx, state.ID.ValueString(), state.WorkspaceID.ValueString())
+	provider, err := d.client.GetProvider(ctx, providerID, state.WorkspaceID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Portkey Provider",
@@ -131,6 +227,7 @@ func (d *providerDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Map response to state
+	state.ID = types.StringValue(providerID)
 	state.Slug = types.StringValue(provider.Slug)
 	state.Name = types.StringValue(provider.Name)
 	state.Status = types.StringValue(provider.Status)
@@ -149,6 +246,25 @@ func (d *providerDataSource) Read(ctx context.Context, req datasource.ReadReques
 		state.Note = types.StringNull()
 	}
 
+	state.ModelConfig = jsonObjectToString(types.StringNull(), provider.ModelConfig)
+	state.ExpiresAt = rfc3339ToString(types.StringNull(), provider.ExpiresAt)
+
+	// Handle usage_limits
+	ulObj, ulDiags := apiKeyUsageLimitsToTerraform(provider.UsageLimits)
+	resp.Diagnostics.Append(ulDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.UsageLimits = ulObj
+
+	// Handle rate_limits
+	rlList, rlDiags := apiKeyRateLimitsToTerraformList(provider.RateLimits)
+	resp.Diagnostics.Append(rlDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.RateLimits = rlList
+
 	// Set state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewProviderListResource lists the providers of a workspace for
+// `terraform query`.
+func NewProviderListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.Provider]{
+		typeName:       "provider",
+		kind:           "providers",
+		title:          "Providers",
+		workspaceScope: listWorkspaceRequired,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.Provider, error) {
+			return c.ListProviders(ctx, workspaceID)
+		},
+		fields: map[string]listResourceField[client.Provider]{
+			"name":           {"name", func(p client.Provider) string { return p.Name }},
+			"slug":           {"slug", func(p client.Provider) string { return p.Slug }},
+			"integration_id": {"integration", func(p client.Provider) string { return p.IntegrationID }},
+			"status":         {"status", func(p client.Provider) string { return p.Status }},
+		},
+		identity: func(p client.Provider, workspaceID string) map[string]string {
+			if p.WorkspaceID != "" {
+				workspaceID = p.WorkspaceID
+			}
+			return map[string]string{"workspace_id": workspaceID, "id": p.ID}
+		},
+		displayName: func(p client.Provider) string { return p.Name },
+		resource:    NewProviderResource,
+	})
+}
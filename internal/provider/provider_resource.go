@@ -2,14 +2,20 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
@@ -19,6 +25,9 @@ var (
 	_ resource.Resource                = &providerResource{}
 	_ resource.ResourceWithConfigure   = &providerResource{}
 	_ resource.ResourceWithImportState = &providerResource{}
+	_ resource.ResourceWithMoveState   = &providerResource{}
+	_ resource.ResourceWithModifyPlan  = &providerResource{}
+	_ resource.ResourceWithIdentity    = &providerResource{}
 )
 
 // NewProviderResource is a helper function to simplify the provider implementation.
@@ -37,9 +46,14 @@ type providerResourceModel struct {
 	Slug          types.String `tfsdk:"slug"`
 	Name          types.String `tfsdk:"name"`
 	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug types.String `tfsdk:"workspace_slug"`
 	IntegrationID types.String `tfsdk:"integration_id"`
 	AIProviderID  types.String `tfsdk:"ai_provider_id"`
 	Note          types.String `tfsdk:"note"`
+	ModelConfig   types.String `tfsdk:"model_config"`
+	RateLimits    types.List   `tfsdk:"rate_limits"`
+	UsageLimits   types.Object `tfsdk:"usage_limits"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
 	Status        types.String `tfsdk:"status"`
 	CreatedAt     types.String `tfsdk:"created_at"`
 }
@@ -80,12 +94,10 @@ organization-level integrations within specific workspaces with optional rate li
 				Required:    true,
 			},
 			"workspace_id": schema.StringAttribute{
-				Description: "Workspace ID (UUID) where this provider will be created. Required.",
+				Description: "ID or slug of the workspace where this provider will be created. Required.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"integration_id": schema.StringAttribute{
 				Description: "Integration slug or ID to use. Must be an integration enabled for the workspace.",
 				Required:    true,
@@ -101,6 +113,94 @@ organization-level integrations within specific workspaces with optional rate li
 				Description: "Optional note or description for this provider.",
 				Optional:    true,
 			},
+			"model_config": schema.StringAttribute{
+				Description: "JSON object of provider-specific model configuration (e.g., Azure deployment settings). Must be a JSON object.",
+				Optional:    true,
+			},
+			"usage_limits": schema.SingleNestedAttribute{
+				Description: "Usage limits (budget) for this provider.",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Limit type: 'tokens' to cap on token consumption, 'cost' to cap on dollar spend.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("tokens", "cost"),
+						},
+					},
+					"credit_limit": schema.Int64Attribute{
+						Description: "The credit limit value (required when usage_limits is set). Interpreted as USD when type is 'cost', or token count when type is 'tokens'. Minimum 0.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"alert_threshold": schema.Int64Attribute{
+						Description: "Send alert emails when usage reaches this value. Must be ≤ credit_limit. Minimum 0.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"periodic_reset": schema.StringAttribute{
+						Description: "When to reset the usage counter: 'monthly' or 'weekly'.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("monthly", "weekly"),
+						},
+					},
+					"periodic_reset_days": schema.Int64Attribute{
+						Description: "Custom reset interval in days (1–365). Alternative to periodic_reset for non-standard cadences.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 365),
+						},
+					},
+					"next_usage_reset_at": schema.StringAttribute{
+						Description: "ISO8601 datetime for the next scheduled usage reset. Optional override; computed by the API when periodic_reset is set.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"rate_limits": schema.ListNestedAttribute{
+				Description: "Rate limits for this provider.",
+				Optional:    true,
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of rate limit: 'requests' or 'tokens'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("requests", "tokens"),
+							},
+						},
+						"unit": schema.StringAttribute{
+							Description: "Rate limit unit: 'rpm' (per minute), 'rph' (per hour), 'rpd' (per day), 'rps' (per second), 'rpw' (per week).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("rpm", "rph", "rpd", "rps", "rpw"),
+							},
+						},
+						"value": schema.Int64Attribute{
+							Description: "The rate limit value. Minimum 0.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC3339 datetime when this provider expires (e.g. \"2026-12-31T23:59:59Z\"). Omit for a non-expiring provider; removing it clears the expiry.",
+				Optional:    true,
+			},
 			"status": schema.StringAttribute{
 				Description: "Status of the provider (active, archived).",
 				Computed:    true,
@@ -113,6 +213,16 @@ organization-level integrations within specific workspaces with optional rate li
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *providerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityAttribute("Workspace identifier (UUID)."),
+			"id":           identityAttribute("Provider identifier."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *providerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -133,6 +243,51 @@ func (r *providerResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+// ModifyPlan plans workspace_id changes and validates the limit, expiry and
+// model_config attributes at plan time.
+func (r *providerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config providerResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// usage_limits follows the same rules as on portkey_api_key.
+	resp.Diagnostics.Append(validateAPIKeyUsageLimitsConfig(config.UsageLimits, path.Root("usage_limits"))...)
+
+	if !config.ExpiresAt.IsNull() && !config.ExpiresAt.IsUnknown() {
+		val := config.ExpiresAt.ValueString()
+		if _, err := time.Parse(time.RFC3339, val); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Invalid RFC3339 Datetime",
+				fmt.Sprintf("expires_at must be a valid RFC3339 datetime (e.g. \"2026-12-31T23:59:59Z\"), got: %q", val),
+			)
+		}
+	}
+
+	if !config.ModelConfig.IsNull() && !config.ModelConfig.IsUnknown() {
+		if _, err := parseModelConfig(config.ModelConfig); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("model_config"),
+				"Invalid model_config JSON",
+				"The model_config attribute must be a JSON object: "+err.Error(),
+			)
+		}
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *providerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -158,6 +313,31 @@ func (r *providerResource) Create(ctx context.Context, req resource.CreateReques
 		createReq.Note = plan.Note.ValueString()
 	}
 
+	if !plan.ModelConfig.IsNull() && !plan.ModelConfig.IsUnknown() {
+		modelConfig, err := parseModelConfig(plan.ModelConfig)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("model_config"),
+				"Invalid model_config JSON",
+				"The model_config attribute must be a JSON object: "+err.Error(),
+			)
+			return
+		}
+		createReq.ModelConfig = modelConfig
+	}
+
+	if !plan.UsageLimits.IsNull() && !plan.UsageLimits.IsUnknown() {
+		createReq.UsageLimits = terraformToAPIKeyUsageLimits(plan.UsageLimits)
+	}
+
+	if !plan.RateLimits.IsNull() && !plan.RateLimits.IsUnknown() {
+		createReq.RateLimits = terraformToAPIKeyRateLimits(plan.RateLimits)
+	}
+
+	if !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown() {
+		createReq.ExpiresAt = plan.ExpiresAt.ValueString()
+	}
+
 	// Create provider
 	createResp, err := r.client.CreateProvider(ctx, createReq)
 	if err != nil {
@@ -189,12 +369,31 @@ func (r *providerResource) Create(ctx context.Context, req resource.CreateReques
 		plan.Note = types.StringValue(provider.Note)
 	}
 
+	// model_config and expires_at are Optional (not Computed): keep the planned
+	// values so the post-apply state matches the plan exactly.
+
+	ulObj, ulDiags := reconcileAPIKeyUsageLimits(plan.UsageLimits, provider.UsageLimits)
+	resp.Diagnostics.Append(ulDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.UsageLimits = ulObj
+
+	rlList, rlDiags := reconcileAPIKeyRateLimits(plan.RateLimits, provider.RateLimits)
+	resp.Diagnostics.Append(rlDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.RateLimits = rlList
+
 	// Set state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -243,12 +442,35 @@ func (r *providerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		state.Note = types.StringValue(provider.Note)
 	}
 
+	// Keep the user's JSON formatting and timestamp spelling when the API value
+	// is semantically the same.
+	state.ModelConfig = jsonObjectToString(state.ModelConfig, provider.ModelConfig)
+	state.ExpiresAt = rfc3339ToString(state.ExpiresAt, provider.ExpiresAt)
+
+	// Handle usage_limits and rate_limits (see reconcileAPIKeyUsageLimits and
+	// reconcileAPIKeyRateLimits for the null-vs-empty rules).
+	ulObj, ulDiags := reconcileAPIKeyUsageLimits(state.UsageLimits, provider.UsageLimits)
+	resp.Diagnostics.Append(ulDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.UsageLimits = ulObj
+
+	rlList, rlDiags := reconcileAPIKeyRateLimits(state.RateLimits, provider.RateLimits)
+	resp.Diagnostics.Append(rlDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.RateLimits = rlList
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -269,6 +491,15 @@ func (r *providerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Read config to detect removed blocks: for Optional+Computed attributes
+	// the plan is Unknown when the user removes them, config is null.
+	var config providerResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build update request
 	updateReq := client.UpdateProviderRequest{
 		Name:        plan.Name.ValueString(),
@@ -279,6 +510,56 @@ func (r *providerResource) Update(ctx context.Context, req resource.UpdateReques
 		updateReq.Note = plan.Note.ValueString()
 	}
 
+	// Handle usage_limits and rate_limits with the API key three-state
+	// semantics. Only send an explicit null when there is something to clear.
+	if !config.UsageLimits.IsNull() || !state.UsageLimits.IsNull() {
+		updateReq.UsageLimits = marshalAPIKeyUsageLimitsForUpdate(config.UsageLimits)
+	}
+	if !config.RateLimits.IsNull() || !state.RateLimits.IsNull() {
+		updateReq.RateLimits = marshalAPIKeyRateLimitsForUpdate(config.RateLimits)
+	}
+
+	// Handle model_config: send the raw JSON when set, null when removed.
+	if !plan.ModelConfig.IsNull() && !plan.ModelConfig.IsUnknown() {
+		modelConfig, err := parseModelConfig(plan.ModelConfig)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("model_config"),
+				"Invalid model_config JSON",
+				"The model_config attribute must be a JSON object: "+err.Error(),
+			)
+			return
+		}
+		data, err := json.Marshal(modelConfig)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("model_config"),
+				"Error Encoding model_config",
+				"Could not encode model_config: "+err.Error(),
+			)
+			return
+		}
+		updateReq.ModelConfig = data
+	} else if plan.ModelConfig.IsNull() && !state.ModelConfig.IsNull() {
+		updateReq.ModelConfig = client.JSONNull
+	}
+
+	// Handle expires_at: send the new datetime when set, null when removed.
+	if !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown() {
+		data, err := json.Marshal(plan.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Error Encoding expires_at",
+				fmt.Sprintf("Could not encode expires_at value %q: %s", plan.ExpiresAt.ValueString(), err),
+			)
+			return
+		}
+		updateReq.ExpiresAt = data
+	} else if plan.ExpiresAt.IsNull() && !state.ExpiresAt.IsNull() {
+		updateReq.ExpiresAt = client.JSONNull
+	}
+
 	provider, err := r.client.UpdateProvider(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -295,11 +576,39 @@ func (r *providerResource) Update(ctx context.Context, req resource.UpdateReques
 	plan.AIProviderID = types.StringValue(provider.AIProviderID)
 	plan.CreatedAt = types.StringValue(provider.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
 
+	// Handle usage_limits: if we sent null to clear, trust that (the API may
+	// return stale data). Otherwise keep the configured values and take only
+	// the computed next_usage_reset_at from the API response.
+	if config.UsageLimits.IsNull() {
+		plan.UsageLimits = types.ObjectNull(apiKeyUsageLimitsAttrTypes)
+	} else {
+		ulObj, ulDiags := reconcileAPIKeyUsageLimits(config.UsageLimits, provider.UsageLimits)
+		resp.Diagnostics.Append(ulDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.UsageLimits = ulObj
+	}
+
+	// Handle rate_limits — same approach
+	if config.RateLimits.IsNull() {
+		plan.RateLimits = types.ListNull(apiKeyRateLimitsObjectType)
+	} else {
+		rlList, rlDiags := apiKeyRateLimitsToTerraformList(provider.RateLimits)
+		resp.Diagnostics.Append(rlDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.RateLimits = rlList
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -326,6 +635,12 @@ func (r *providerResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports the resource state.
 // Import format: "workspace_id:provider_id"
 func (r *providerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Importing by identity: workspace_id and id come from the identity.
+	if req.ID == "" {
+		importStateFromIdentity(ctx, req.Identity, &resp.State, &resp.Diagnostics)
+		return
+	}
+
 	// Import format: workspace_id:provider_id
 	parts := strings.SplitN(req.ID, ":", 2)
 	if len(parts) != 2 {
@@ -339,6 +654,35 @@ func (r *providerResource) ImportState(ctx context.Context, req resource.ImportS
 	workspaceID := parts[0]
 	providerID := parts[1]
 
+	checkImportedWorkspace(ctx, r.client, workspaceID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), providerID)...)
 }
+
+// MoveState moves portkey_provider state from another provider and restapi_object providers.
+func (r *providerResource) MoveState(_ context.Context) []resource.StateMover {
+	return importStateMovers(r,
+		attributeSource("portkey_provider", "%s:%s", []string{"workspace_id"}, []string{"id"}),
+		restapiObjectSource("/providers", func(id string, object map[string]interface{}) (string, error) {
+			workspaceID := stateString(object, "workspace_id")
+			if workspaceID == "" {
+				return "", fmt.Errorf("the restapi_object has no workspace_id in api_response or data")
+			}
+			return workspaceID + ":" + id, nil
+		}),
+	)
+}
+
+// parseModelConfig decodes the model_config JSON string into the map sent to
+// the API. The value must be a JSON object.
+func parseModelConfig(value types.String) (map[string]interface{}, error) {
+	modelConfig := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &modelConfig); err != nil {
+		return nil, err
+	}
+	return modelConfig, nil
+}
@@ -51,6 +51,30 @@ func TestAccProviderResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("portkey_provider.test", "name", nameUpdated),
 				),
 			},
+			// Add limits and expiry
+			{
+				Config: testAccProviderResourceConfigWithLimits(nameUpdated, workspaceID, integrationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_provider.test", "usage_limits.type", "cost"),
+					resource.TestCheckResourceAttr("portkey_provider.test", "usage_limits.credit_limit", "100"),
+					resource.TestCheckResourceAttr("portkey_provider.test", "usage_limits.alert_threshold", "80"),
+					resource.TestCheckResourceAttr("portkey_provider.test", "usage_limits.periodic_reset", "monthly"),
+					resource.TestCheckResourceAttr("portkey_provider.test", "rate_limits.#", "1"),
+					resource.TestCheckResourceAttr("portkey_provider.test", "rate_limits.0.type", "requests"),
+					resource.TestCheckResourceAttr("portkey_provider.test", "rate_limits.0.unit", "rpm"),
+					resource.TestCheckResourceAttr("portkey_provider.test", "rate_limits.0.value", "100"),
+					resource.TestCheckResourceAttr("portkey_provider.test", "expires_at", "2030-12-31T23:59:59Z"),
+				),
+			},
+			// Remove limits and expiry
+			{
+				Config: testAccProviderResourceConfig(nameUpdated, workspaceID, integrationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("portkey_provider.test", "usage_limits.credit_limit"),
+					resource.TestCheckNoResourceAttr("portkey_provider.test", "rate_limits.#"),
+					resource.TestCheckNoResourceAttr("portkey_provider.test", "expires_at"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -76,3 +100,29 @@ resource "portkey_provider" "test" {
 }
 `, name, workspaceID, integrationID, note)
 }
+
+func testAccProviderResourceConfigWithLimits(name, workspaceID, integrationID string) string {
+	return fmt.Sprintf(`
+resource "portkey_provider" "test" {
+  name           = %[1]q
+  workspace_id   = %[2]q
+  integration_id = %[3]q
+  expires_at     = "2030-12-31T23:59:59Z"
+
+  usage_limits = {
+    type            = "cost"
+    credit_limit    = 100
+    alert_threshold = 80
+    periodic_reset  = "monthly"
+  }
+
+  rate_limits = [
+    {
+      type  = "requests"
+      unit  = "rpm"
+      value = 100
+    }
+  ]
+}
+`, name, workspaceID, integrationID)
+}
@@ -141,7 +141,10 @@ func TestProvider_HasChildResources(t *testing.T) {
 	expectedResources := []string{
 		"portkey_workspace",
 		"portkey_workspace_member",
+		"portkey_workspace_members",
+		"portkey_user",
 		"portkey_user_invite",
+		"portkey_user_invites",
 		"portkey_integration",
 		"portkey_integration_workspace_access",
 		"portkey_integration_model_access",
@@ -149,7 +152,9 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"portkey_provider",
 		"portkey_config",
 		"portkey_prompt",
+		"portkey_prompt_default_version",
 		"portkey_prompt_partial",
+		"portkey_prompt_partial_default_version",
 		"portkey_prompt_collection",
 		"portkey_guardrail",
 		"portkey_usage_limits_policy",
@@ -175,6 +180,8 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"portkey_workspaces",
 		"portkey_user",
 		"portkey_users",
+		"portkey_workspace_members",
+		"portkey_user_invites",
 		"portkey_integration",
 		"portkey_integrations",
 		"portkey_integration_workspaces",
@@ -185,10 +192,13 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"portkey_providers",
 		"portkey_config",
 		"portkey_configs",
+		"portkey_config_versions",
 		"portkey_prompt",
 		"portkey_prompts",
+		"portkey_prompt_versions",
 		"portkey_prompt_partial",
 		"portkey_prompt_partials",
+		"portkey_prompt_partial_versions",
 		"portkey_prompt_collection",
 		"portkey_prompt_collections",
 		"portkey_guardrail",
@@ -30,6 +30,9 @@ type providersDataSource struct {
 type providersDataSourceModel struct {
 	WorkspaceID types.String            `tfsdk:"workspace_id"`
 	Providers   []providerDataItemModel `tfsdk:"providers"`
+	Filter      []listFilterModel       `tfsdk:"filter"`
+	IDs         types.List              `tfsdk:"ids"`
+	Slugs       types.List              `tfsdk:"slugs"`
 }
 
 // providerDataItemModel maps individual provider data.
@@ -40,6 +43,10 @@ type providerDataItemModel struct {
 	IntegrationID types.String `tfsdk:"integration_id"`
 	AIProviderID  types.String `tfsdk:"ai_provider_id"`
 	Note          types.String `tfsdk:"note"`
+	ModelConfig   types.String `tfsdk:"model_config"`
+	RateLimits    types.List   `tfsdk:"rate_limits"`
+	UsageLimits   types.Object `tfsdk:"usage_limits"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
 	Status        types.String `tfsdk:"status"`
 	CreatedAt     types.String `tfsdk:"created_at"`
 }
@@ -87,6 +94,64 @@ func (d *providersDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 							Description: "Note or description for this provider.",
 							Computed:    true,
 						},
+						"model_config": schema.StringAttribute{
+							Description: "JSON object of provider-specific model configuration.",
+							Computed:    true,
+						},
+						"usage_limits": schema.SingleNestedAttribute{
+							Description: "Usage limits for this provider.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"credit_limit": schema.Int64Attribute{
+									Description: "The credit limit value.",
+									Computed:    true,
+								},
+								"alert_threshold": schema.Int64Attribute{
+									Description: "Alert threshold value.",
+									Computed:    true,
+								},
+								"periodic_reset": schema.StringAttribute{
+									Description: "When to reset the usage: 'monthly' or 'weekly'.",
+									Computed:    true,
+								},
+								"type": schema.StringAttribute{
+									Description: "Usage limit type ('cost' or 'tokens').",
+									Computed:    true,
+								},
+								"next_usage_reset_at": schema.StringAttribute{
+									Description: "Timestamp when the usage counters will next be reset.",
+									Computed:    true,
+								},
+								"periodic_reset_days": schema.Int64Attribute{
+									Description: "Custom reset interval in days (1-365).",
+									Computed:    true,
+								},
+							},
+						},
+						"rate_limits": schema.ListNestedAttribute{
+							Description: "Rate limits for this provider.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "Type of rate limit.",
+										Computed:    true,
+									},
+									"unit": schema.StringAttribute{
+										Description: "Rate limit unit.",
+										Computed:    true,
+									},
+									"value": schema.Int64Attribute{
+										Description: "The rate limit value.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "Timestamp when the provider expires, if set.",
+							Computed:    true,
+						},
 						"status": schema.StringAttribute{
 							Description: "Status of the provider (active, archived).",
 							Computed:    true,
@@ -98,6 +163,11 @@ func (d *providersDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 					},
 				},
 			},
+			"ids":   listIDsAttribute("providers"),
+			"slugs": listSlugsAttribute("providers"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("providers"),
 		},
 	}
 }
@@ -146,12 +216,30 @@ func (d *providersDataSource) Read(ctx context.Context, req datasource.ReadReque
 	// Map response to state
 	state.Providers = make([]providerDataItemModel, 0, len(providers))
 	for _, p := range providers {
+		// Handle usage_limits
+		ulObj, ulDiags := apiKeyUsageLimitsToTerraform(p.UsageLimits)
+		resp.Diagnostics.Append(ulDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Handle rate_limits
+		rlList, rlDiags := apiKeyRateLimitsToTerraformList(p.RateLimits)
+		resp.Diagnostics.Append(rlDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		item := providerDataItemModel{
 			ID:           types.StringValue(p.ID),
 			Slug:         types.StringValue(p.Slug),
 			Name:         types.StringValue(p.Name),
 			Status:       types.StringValue(p.Status),
 			AIProviderID: types.StringValue(p.AIProviderID),
+			ModelConfig:  jsonObjectToString(types.StringNull(), p.ModelConfig),
+			RateLimits:   rlList,
+			UsageLimits:  ulObj,
+			ExpiresAt:    rfc3339ToString(types.StringNull(), p.ExpiresAt),
 			CreatedAt:    types.StringValue(p.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
 		}
 
@@ -170,6 +258,13 @@ func (d *providersDataSource) Read(ctx context.Context, req datasource.ReadReque
 		state.Providers = append(state.Providers, item)
 	}
 
+	state.Providers = applyListFilters(state.Providers, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Providers, "id")
+	state.Slugs = listItemStrings(state.Providers, "slug")
+
 	// Set state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
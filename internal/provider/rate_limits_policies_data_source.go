@@ -31,6 +31,8 @@ type rateLimitsPoliciesDataSource struct {
 type rateLimitsPoliciesDataSourceModel struct {
 	WorkspaceID types.String                   `tfsdk:"workspace_id"`
 	Policies    []rateLimitsPolicySummaryModel `tfsdk:"policies"`
+	Filter      []listFilterModel              `tfsdk:"filter"`
+	IDs         types.List                     `tfsdk:"ids"`
 }
 
 // rateLimitsPolicySummaryModel maps policy summary data.
@@ -114,6 +116,10 @@ func (d *rateLimitsPoliciesDataSource) Schema(_ context.Context, _ datasource.Sc
 					},
 				},
 			},
+			"ids": listIDsAttribute("policies"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("policies"),
 		},
 	}
 }
@@ -189,6 +195,12 @@ func (d *rateLimitsPoliciesDataSource) Read(ctx context.Context, req datasource.
 		state.Policies = append(state.Policies, policyState)
 	}
 
+	state.Policies = applyListFilters(state.Policies, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Policies, "id")
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewRateLimitsPolicyListResource lists rate limits policies for
+// `terraform query`.
+func NewRateLimitsPolicyListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.RateLimitsPolicy]{
+		typeName:       "rate_limits_policy",
+		kind:           "rate limits policies",
+		title:          "Rate Limits Policies",
+		workspaceScope: listWorkspaceOptional,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.RateLimitsPolicy, error) {
+			return c.ListRateLimitsPolicies(ctx, workspaceID)
+		},
+		fields: map[string]listResourceField[client.RateLimitsPolicy]{
+			"name":   {"name", func(p client.RateLimitsPolicy) string { return p.Name }},
+			"type":   {"type", func(p client.RateLimitsPolicy) string { return p.Type }},
+			"status": {"status", func(p client.RateLimitsPolicy) string { return p.Status }},
+		},
+		identity: func(p client.RateLimitsPolicy, _ string) map[string]string {
+			return map[string]string{"id": p.ID}
+		},
+		displayName: func(p client.RateLimitsPolicy) string { return p.Name },
+		resource:    NewRateLimitsPolicyResource,
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +20,8 @@ var (
 	_ resource.Resource                = &rateLimitsPolicyResource{}
 	_ resource.ResourceWithConfigure   = &rateLimitsPolicyResource{}
 	_ resource.ResourceWithImportState = &rateLimitsPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &rateLimitsPolicyResource{}
+	_ resource.ResourceWithIdentity    = &rateLimitsPolicyResource{}
 )
 
 // NewRateLimitsPolicyResource is a helper function to simplify the provider implementation.
@@ -33,17 +36,18 @@ type rateLimitsPolicyResource struct {
 
 // rateLimitsPolicyResourceModel maps the resource schema data.
 type rateLimitsPolicyResourceModel struct {
-	ID          types.String  `tfsdk:"id"`
-	Name        types.String  `tfsdk:"name"`
-	WorkspaceID types.String  `tfsdk:"workspace_id"`
-	Conditions  types.String  `tfsdk:"conditions"`
-	GroupBy     types.String  `tfsdk:"group_by"`
-	Type        types.String  `tfsdk:"type"`
-	Unit        types.String  `tfsdk:"unit"`
-	Value       types.Float64 `tfsdk:"value"`
-	Status      types.String  `tfsdk:"status"`
-	CreatedAt   types.String  `tfsdk:"created_at"`
-	UpdatedAt   types.String  `tfsdk:"updated_at"`
+	ID            types.String  `tfsdk:"id"`
+	Name          types.String  `tfsdk:"name"`
+	WorkspaceID   types.String  `tfsdk:"workspace_id"`
+	WorkspaceSlug types.String  `tfsdk:"workspace_slug"`
+	Conditions    types.String  `tfsdk:"conditions"`
+	GroupBy       types.String  `tfsdk:"group_by"`
+	Type          types.String  `tfsdk:"type"`
+	Unit          types.String  `tfsdk:"unit"`
+	Value         types.Float64 `tfsdk:"value"`
+	Status        types.String  `tfsdk:"status"`
+	CreatedAt     types.String  `tfsdk:"created_at"`
+	UpdatedAt     types.String  `tfsdk:"updated_at"`
 }
 
 // Metadata returns the resource type name.
@@ -70,10 +74,8 @@ func (r *rateLimitsPolicyResource) Schema(_ context.Context, _ resource.SchemaRe
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID to create the policy in.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"conditions": schema.StringAttribute{
 				Description: "JSON array of conditions that define which requests the policy applies to. Each condition has 'key' and 'value'.",
 				Required:    true,
@@ -119,6 +121,15 @@ func (r *rateLimitsPolicyResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *rateLimitsPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("Rate limits policy identifier (UUID)."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *rateLimitsPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -139,6 +150,12 @@ func (r *rateLimitsPolicyResource) Configure(_ context.Context, req resource.Con
 	r.client = client
 }
 
+// ModifyPlan replaces the policy only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *rateLimitsPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *rateLimitsPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -208,6 +225,8 @@ func (r *rateLimitsPolicyResource) Create(ctx context.Context, req resource.Crea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -246,6 +265,8 @@ func (r *rateLimitsPolicyResource) Read(ctx context.Context, req resource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -299,6 +320,8 @@ func (r *rateLimitsPolicyResource) Update(ctx context.Context, req resource.Upda
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -324,7 +347,7 @@ func (r *rateLimitsPolicyResource) Delete(ctx context.Context, req resource.Dele
 
 // ImportState imports the resource state.
 func (r *rateLimitsPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // mapPolicyToState maps a RateLimitsPolicy API response to the Terraform state model
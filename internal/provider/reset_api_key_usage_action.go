@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &resetAPIKeyUsageAction{}
+	_ action.ActionWithConfigure = &resetAPIKeyUsageAction{}
+)
+
+// NewResetAPIKeyUsageAction is a helper function to simplify the provider implementation.
+func NewResetAPIKeyUsageAction() action.Action {
+	return &resetAPIKeyUsageAction{}
+}
+
+// resetAPIKeyUsageAction resets the usage counters of an API key.
+type resetAPIKeyUsageAction struct {
+	client *client.Client
+}
+
+// resetAPIKeyUsageActionModel maps the action schema data.
+type resetAPIKeyUsageActionModel struct {
+	APIKeyID types.String `tfsdk:"api_key_id"`
+}
+
+// Metadata returns the action type name.
+func (a *resetAPIKeyUsageAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reset_api_key_usage"
+}
+
+// Schema defines the schema for the action.
+func (a *resetAPIKeyUsageAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resets the usage counters of a Portkey API key, so spending counts against its `usage_limits` " +
+			"start again from zero. Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"api_key_id": schema.StringAttribute{
+				Description: "ID (UUID) of the API key whose usage to reset.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *resetAPIKeyUsageAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke resets the API key's usage.
+func (a *resetAPIKeyUsageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data resetAPIKeyUsageActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.APIKeyID.ValueString()
+	reset := true
+	if _, err := a.client.UpdateAPIKey(ctx, id, client.UpdateAPIKeyRequest{ResetUsage: &reset}); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resetting Portkey API Key Usage",
+			fmt.Sprintf("Could not reset usage of API key %s: %s", id, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Reset usage of API key %s", id)})
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Resource identities
+//
+// Identity attributes are strings named after the state attribute they
+// mirror (id, slug, workspace_id), so the helpers below copy values between
+// state and identity by name. Objects whose slug can be renamed are
+// identified by their immutable id, plus workspace_id when they belong to a
+// workspace; importing them by slug remains possible with an import ID.
+
+// identityAttribute returns a string identity attribute that must be given
+// when importing by identity.
+func identityAttribute(description string) identityschema.StringAttribute {
+	return identityschema.StringAttribute{
+		Description:       description,
+		RequiredForImport: true,
+	}
+}
+
+// optionalIdentityAttribute returns a string identity attribute that may be
+// omitted when importing by identity, such as the workspace_id of an object
+// that can also belong to the organisation.
+func optionalIdentityAttribute(description string) identityschema.StringAttribute {
+	return identityschema.StringAttribute{
+		Description:       description,
+		OptionalForImport: true,
+	}
+}
+
+// setIdentityFromState fills the null attributes of identity from the state
+// attributes of the same name. Attributes already set are kept, so an
+// identity never changes once recorded; a workspace_id is recorded as the
+// workspace UUID when it can be resolved. Call it after State.Set in Create,
+// Read and Update.
+func setIdentityFromState(ctx context.Context, c *client.Client, state tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	if identity == nil || state.Raw.IsNull() {
+		return
+	}
+
+	for name := range identity.Schema.GetAttributes() {
+		var current types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(name), &current)...)
+		if diags.HasError() {
+			return
+		}
+		if !current.IsNull() && !current.IsUnknown() {
+			continue
+		}
+
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		if diags.HasError() {
+			return
+		}
+		if name == "workspace_id" && !value.IsNull() && !value.IsUnknown() {
+			value = types.StringValue(identityWorkspaceID(ctx, c, value.ValueString()))
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// identityWorkspaceID returns the UUID of the workspace referenced by ref, or
+// ref itself when it cannot be resolved.
+func identityWorkspaceID(ctx context.Context, c *client.Client, ref string) string {
+	if c == nil {
+		return ref
+	}
+	if ws, err := c.ResolveWorkspace(ctx, ref); err == nil {
+		return ws.ID
+	}
+	return ref
+}
+
+// importStateFromIdentity copies every identity attribute into the state
+// attribute of the same name. ImportState calls it when the import uses an
+// identity rather than an ID (req.ID is empty).
+func importStateFromIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, state *tfsdk.State, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+	for name := range identity.Schema.GetAttributes() {
+		var value types.String
+		diags.Append(identity.GetAttribute(ctx, path.Root(name), &value)...)
+		if diags.HasError() {
+			return
+		}
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// importIDFromIdentity returns the import ID of an import that gives an
+// identity rather than an ID: the named identity attributes joined with "/",
+// in the order of the resource's import ID format. An import by ID is
+// returned unchanged, so ImportState can parse both the same way.
+func importIDFromIdentity(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics, names ...string) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		var value types.String
+		diags.Append(req.Identity.GetAttribute(ctx, path.Root(name), &value)...)
+		parts[i] = value.ValueString()
+	}
+	return strings.Join(parts, "/")
+}
+
+// importSlugFromIdentity completes an import by identity for a resource that
+// is identified by id but read by slug. It copies the identity into state and
+// sets slug to the value slugOf returns for the id and workspace_id, adding
+// an error when slugOf finds no object. kind is the lower-case resource name
+// used in diagnostics, e.g. "config".
+func importSlugFromIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, kind string, slugOf func(id, workspaceID string) (string, error)) {
+	importStateFromIdentity(ctx, req.Identity, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Identity == nil {
+		return
+	}
+
+	var id, workspaceID types.String
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+	if _, ok := req.Identity.Schema.GetAttributes()["workspace_id"]; ok {
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug, err := slugOf(id.ValueString(), workspaceID.ValueString())
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error Importing Portkey Resource",
+			fmt.Sprintf("Could not look up the %s with ID %s: %s", kind, id.ValueString(), err),
+		)
+	case slug == "":
+		resp.Diagnostics.AddError(
+			"Cannot Import Non-Existent Remote Object",
+			fmt.Sprintf("No %s with ID %s exists.", kind, id.ValueString()),
+		)
+	default:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), slug)...)
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// TestResources_HaveIdentity verifies every resource with a remote object
+// has an identity whose attributes mirror state attributes of the same name,
+// which the identity helpers rely on.
+func TestResources_HaveIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	// portkey_user_invites manages a set of invitations and has no single
+	// remote object to identify.
+	withoutIdentity := map[string]bool{"portkey_user_invites": true}
+
+	for _, f := range New("test")().Resources(ctx) {
+		r := f()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "portkey"}, &metadata)
+
+		withIdentity, ok := r.(resource.ResourceWithIdentity)
+		if !ok {
+			if !withoutIdentity[metadata.TypeName] {
+				t.Errorf("%s does not implement ResourceWithIdentity", metadata.TypeName)
+			}
+			continue
+		}
+
+		var schema resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schema)
+		var identity resource.IdentitySchemaResponse
+		withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identity)
+		if diags := identity.IdentitySchema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("%s: invalid identity schema: %v", metadata.TypeName, diags)
+		}
+
+		for name := range identity.IdentitySchema.Attributes {
+			if _, ok := schema.Schema.Attributes[name]; !ok {
+				t.Errorf("%s: identity attribute %s has no state attribute", metadata.TypeName, name)
+			}
+		}
+	}
+}
+
+func TestImportIDFromIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	s := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityAttribute("Workspace."),
+			"user_id":      identityAttribute("User."),
+		},
+	}
+	identity := &tfsdk.ResourceIdentity{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"workspace_id": tftypes.NewValue(tftypes.String, "ws-uuid-1"),
+			"user_id":      tftypes.NewValue(tftypes.String, "user-1"),
+		}),
+	}
+
+	var diags diag.Diagnostics
+	got := importIDFromIdentity(ctx, resource.ImportStateRequest{Identity: identity}, &diags, "workspace_id", "user_id")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got != "ws-uuid-1/user-1" {
+		t.Errorf("import ID = %q, want %q", got, "ws-uuid-1/user-1")
+	}
+
+	got = importIDFromIdentity(ctx, resource.ImportStateRequest{ID: "ws-prod/user-2", Identity: identity}, &diags, "workspace_id", "user_id")
+	if got != "ws-prod/user-2" {
+		t.Errorf("import ID = %q, want the given ID", got)
+	}
+}
+
+// TestImportSlugFromIdentity verifies that importing a config by its id
+// identity looks up the slug Read needs, and that an unknown id fails.
+func TestImportSlugFromIdentity(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/configs" || r.URL.Query().Get("workspace_id") != "ws-uuid-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"cfg-1","slug":"pc-routing"},{"id":"cfg-2","slug":"pc-renamed"}]}`))
+	}))
+	defer srv.Close()
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	r := NewConfigResource().(*configResource)
+	r.client = c
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+
+	importConfig := func(id string) resource.ImportStateResponse {
+		req := resource.ImportStateRequest{Identity: &tfsdk.ResourceIdentity{
+			Schema: identityResp.IdentitySchema,
+			Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+				"id":           tftypes.NewValue(tftypes.String, id),
+				"workspace_id": tftypes.NewValue(tftypes.String, "ws-uuid-1"),
+			}),
+		}}
+		resp := resource.ImportStateResponse{State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}}
+		r.ImportState(ctx, req, &resp)
+		return resp
+	}
+
+	resp := importConfig("cfg-2")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var slug, workspaceID types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("slug"), &slug)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+	if slug.ValueString() != "pc-renamed" || workspaceID.ValueString() != "ws-uuid-1" {
+		t.Errorf("slug = %s, workspace_id = %s; want pc-renamed, ws-uuid-1", slug, workspaceID)
+	}
+
+	if resp := importConfig("cfg-missing"); !resp.Diagnostics.HasError() {
+		t.Error("expected an error for an unknown id")
+	}
+}
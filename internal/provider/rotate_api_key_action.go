@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &rotateAPIKeyAction{}
+	_ action.ActionWithConfigure = &rotateAPIKeyAction{}
+)
+
+// NewRotateAPIKeyAction is a helper function to simplify the provider implementation.
+func NewRotateAPIKeyAction() action.Action {
+	return &rotateAPIKeyAction{}
+}
+
+// rotateAPIKeyAction rotates an API key on demand.
+type rotateAPIKeyAction struct {
+	client *client.Client
+}
+
+// rotateAPIKeyActionModel maps the action schema data.
+type rotateAPIKeyActionModel struct {
+	APIKeyID           types.String `tfsdk:"api_key_id"`
+	TransitionPeriodMs types.Int64  `tfsdk:"transition_period_ms"`
+}
+
+// Metadata returns the action type name.
+func (a *rotateAPIKeyAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rotate_api_key"
+}
+
+// Schema defines the schema for the action.
+func (a *rotateAPIKeyAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Portkey API key (`POST /api-keys/{id}/rotate`). A new key value is issued and the previous value " +
+			"keeps working for the transition period. Actions return no values, so the new key is not available to Terraform; " +
+			"distribute it from the Portkey dashboard. Use this action only for keys not managed by a `portkey_api_key` resource: " +
+			"rotating a managed key leaves the replaced value in that resource's state, so rotate those with `rotate_trigger` or " +
+			"`rotate_every` instead. Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"api_key_id": schema.StringAttribute{
+				Description: "ID (UUID) of the API key to rotate.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"transition_period_ms": schema.Int64Attribute{
+				Description: "How long (in milliseconds) the previous key value keeps working. Minimum 1800000 (30 minutes). " +
+					"Defaults to the API setting.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1800000),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *rotateAPIKeyAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke rotates the API key.
+func (a *rotateAPIKeyAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data rotateAPIKeyActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.APIKeyID.ValueString()
+
+	// Look the key up first so a wrong ID fails without rotating anything.
+	if _, err := a.client.GetAPIKey(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Portkey API Key",
+			fmt.Sprintf("Could not read API key %s before rotating it: %s", id, err.Error()),
+		)
+		return
+	}
+
+	var rotateReq *client.RotateAPIKeyRequest
+	if !data.TransitionPeriodMs.IsNull() {
+		v := int(data.TransitionPeriodMs.ValueInt64())
+		rotateReq = &client.RotateAPIKeyRequest{KeyTransitionPeriodMs: &v}
+	}
+
+	rotateResp, err := a.client.RotateAPIKey(ctx, id, rotateReq)
+	if err != nil {
+		detail := fmt.Sprintf("Could not rotate API key %s: %s", id, err.Error())
+		if strings.Contains(err.Error(), "403") {
+			detail += "\n\nHint: rotating a key requires the calling Admin API Key to have " +
+				"the matching update scope (organisation_service_api_keys.update, " +
+				"workspace_service_api_keys.update, or workspace_user_api_keys.update)."
+		}
+		resp.Diagnostics.AddError("Error Rotating Portkey API Key", detail)
+		return
+	}
+
+	message := fmt.Sprintf("Rotated API key %s", id)
+	if rotateResp.KeyTransitionExpiresAt != "" {
+		message += "; the previous key value works until " + rotateResp.KeyTransitionExpiresAt
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +23,8 @@ var (
 	_ resource.Resource                     = &scimWorkspaceMappingResource{}
 	_ resource.ResourceWithConfigure        = &scimWorkspaceMappingResource{}
 	_ resource.ResourceWithImportState      = &scimWorkspaceMappingResource{}
+	_ resource.ResourceWithIdentity         = &scimWorkspaceMappingResource{}
+	_ resource.ResourceWithModifyPlan       = &scimWorkspaceMappingResource{}
 	_ resource.ResourceWithConfigValidators = &scimWorkspaceMappingResource{}
 )
 
@@ -39,6 +42,7 @@ type scimWorkspaceMappingResource struct {
 type scimWorkspaceMappingResourceModel struct {
 	ID            types.String `tfsdk:"id"`
 	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug types.String `tfsdk:"workspace_slug"`
 	Role          types.String `tfsdk:"role"`
 	ScimGroupID   types.String `tfsdk:"scim_group_id"`
 	ScimGroupName types.String `tfsdk:"scim_group_name"`
@@ -66,10 +70,8 @@ func (r *scimWorkspaceMappingResource) Schema(_ context.Context, _ resource.Sche
 			"workspace_id": schema.StringAttribute{
 				Description: "ID or slug of the workspace to map the SCIM group to.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"role": schema.StringAttribute{
 				Description: "Role assigned to group members in the workspace. One of: admin, member, manager.",
 				Required:    true,
@@ -110,6 +112,16 @@ func (r *scimWorkspaceMappingResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *scimWorkspaceMappingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityAttribute("Workspace identifier (UUID)."),
+			"id":           identityAttribute("SCIM workspace mapping identifier."),
+		},
+	}
+}
+
 // ConfigValidators enforces that exactly one of scim_group_id or scim_group_name is set.
 func (r *scimWorkspaceMappingResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
@@ -138,6 +150,12 @@ func (r *scimWorkspaceMappingResource) Configure(_ context.Context, req resource
 	r.client = c
 }
 
+// ModifyPlan replaces the mapping only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *scimWorkspaceMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *scimWorkspaceMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan scimWorkspaceMappingResourceModel
@@ -179,6 +197,8 @@ func (r *scimWorkspaceMappingResource) Create(ctx context.Context, req resource.
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -219,6 +239,8 @@ func (r *scimWorkspaceMappingResource) Read(ctx context.Context, req resource.Re
 		// scim_group_name is preserved verbatim from state (not echoed by the API).
 		diags = resp.State.Set(ctx, &state)
 		resp.Diagnostics.Append(diags...)
+		setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+		setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 		return
 	}
 
@@ -226,9 +248,9 @@ func (r *scimWorkspaceMappingResource) Read(ctx context.Context, req resource.Re
 	resp.State.RemoveResource(ctx)
 }
 
-// Update is a no-op. Every editable attribute on the resource has
-// RequiresReplace, so the framework will never invoke Update with real
-// diffs — but the interface still requires the method to exist.
+// Update only records the plan. Every editable attribute on the resource
+// requires replacement, except workspace_id switching between the ID and the
+// slug of the same workspace, which needs no API call.
 func (r *scimWorkspaceMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan scimWorkspaceMappingResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -238,6 +260,8 @@ func (r *scimWorkspaceMappingResource) Update(ctx context.Context, req resource.
 	}
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -265,7 +289,9 @@ func (r *scimWorkspaceMappingResource) Delete(ctx context.Context, req resource.
 // attribute and the API doesn't echo it back in a slug-stable form, so it
 // can't be derived from the mapping alone.
 func (r *scimWorkspaceMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, "/")
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics, "workspace_id", "id")
+
+	parts := strings.Split(id, "/")
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
@@ -274,6 +300,11 @@ func (r *scimWorkspaceMappingResource) ImportState(ctx context.Context, req reso
 		return
 	}
 
+	checkImportedWorkspace(ctx, r.client, parts[0], &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 }
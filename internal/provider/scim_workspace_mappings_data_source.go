@@ -34,6 +34,8 @@ type scimWorkspaceMappingsDataSourceModel struct {
 	ScimGroupID types.String                  `tfsdk:"scim_group_id"`
 	Role        types.String                  `tfsdk:"role"`
 	Mappings    []scimWorkspaceMappingDSModel `tfsdk:"mappings"`
+	Filter      []listFilterModel             `tfsdk:"filter"`
+	IDs         types.List                    `tfsdk:"ids"`
 }
 
 // scimWorkspaceMappingDSModel mirrors a single mapping in the data source response.
@@ -98,6 +100,10 @@ func (d *scimWorkspaceMappingsDataSource) Schema(_ context.Context, _ datasource
 					},
 				},
 			},
+			"ids": listIDsAttribute("mappings"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("mappings"),
 		},
 	}
 }
@@ -187,6 +193,12 @@ func (d *scimWorkspaceMappingsDataSource) Read(ctx context.Context, req datasour
 		})
 	}
 
+	state.Mappings = applyListFilters(state.Mappings, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Mappings, "id")
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
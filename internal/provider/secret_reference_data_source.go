@@ -6,8 +6,11 @@ import (
 
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -54,20 +57,26 @@ func (d *secretReferenceDataSource) Metadata(_ context.Context, req datasource.M
 // Schema defines the schema for the data source.
 func (d *secretReferenceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a specific Portkey secret reference by UUID or slug. " +
+		Description: "Fetches a specific Portkey secret reference by UUID, slug or name. " +
 			"Credential values (auth_config) are intentionally not exposed by this data source.",
 		Attributes: map[string]schema.Attribute{
 			"slug": schema.StringAttribute{
-				Description: "Slug or UUID of the secret reference. Either works.",
-				Required:    true,
+				Description: "Slug or UUID of the secret reference. Either works. Exactly one of slug or name must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"id": schema.StringAttribute{
 				Description: "Secret reference UUID.",
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Human-readable name.",
-				Computed:    true,
+				Description: "Human-readable name. Set it instead of slug to look the secret reference up by name; " +
+					"exactly one secret reference must have this name.",
+				Optional: true,
+				Computed: true,
 			},
 			"description": schema.StringAttribute{
 				Description: "Optional description.",
@@ -147,7 +156,33 @@ func (d *secretReferenceDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	secretRef, err := d.client.GetSecretReference(ctx, state.Slug.ValueString())
+	idOrSlug := state.Slug.ValueString()
+	if !state.Name.IsNull() {
+		name := state.Name.ValueString()
+		secretRefs, err := d.client.ListAllSecretReferences(ctx, client.ListSecretReferencesOptions{Search: name})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Portkey Secret References",
+				err.Error(),
+			)
+			return
+		}
+
+		// search matches loosely; require an exact name.
+		match, err := findOneByName(secretRefs, "secret reference", name, func(sr client.SecretReference) bool {
+			return sr.Name == name
+		}, func(sr client.SecretReference) string { return sr.Slug })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Find Portkey Secret Reference",
+				err.Error(),
+			)
+			return
+		}
+		idOrSlug = match.Slug
+	}
+
+	secretRef, err := d.client.GetSecretReference(ctx, idOrSlug)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Portkey Secret Reference",
@@ -158,8 +193,11 @@ func (d *secretReferenceDataSource) Read(ctx context.Context, req datasource.Rea
 
 	state.ID = types.StringValue(secretRef.ID)
 	// Preserve whatever the user configured (slug or UUID) — do not overwrite
-	// a Required input with the canonical slug. The canonical value is available
+	// a configured input with the canonical slug. The canonical value is available
 	// via `id` (UUID) and, if the user configured a slug, it already matches.
+	if state.Slug.IsNull() {
+		state.Slug = types.StringValue(secretRef.Slug)
+	}
 	state.Name = types.StringValue(secretRef.Name)
 	state.ManagerType = types.StringValue(secretRef.ManagerType)
 	state.SecretPath = types.StringValue(secretRef.SecretPath)
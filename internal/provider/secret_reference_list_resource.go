@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewSecretReferenceListResource lists secret references for
+// `terraform query`.
+func NewSecretReferenceListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.SecretReference]{
+		typeName: "secret_reference",
+		kind:     "secret references",
+		title:    "Secret References",
+		list: func(ctx context.Context, c *client.Client, _ string) ([]client.SecretReference, error) {
+			return c.ListAllSecretReferences(ctx, client.ListSecretReferencesOptions{})
+		},
+		fields: map[string]listResourceField[client.SecretReference]{
+			"name":         {"name", func(s client.SecretReference) string { return s.Name }},
+			"slug":         {"slug", func(s client.SecretReference) string { return s.Slug }},
+			"manager_type": {"secret manager type (e.g. `aws_sm`)", func(s client.SecretReference) string { return s.ManagerType }},
+		},
+		identity: func(s client.SecretReference, _ string) map[string]string {
+			return map[string]string{"id": s.ID}
+		},
+		state: func(s client.SecretReference) map[string]string {
+			return map[string]string{"slug": s.Slug}
+		},
+		displayName: func(s client.SecretReference) string { return s.Name },
+		resource:    NewSecretReferenceResource,
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,6 +26,7 @@ var (
 	_ resource.ResourceWithConfigure   = &secretReferenceResource{}
 	_ resource.ResourceWithImportState = &secretReferenceResource{}
 	_ resource.ResourceWithModifyPlan  = &secretReferenceResource{}
+	_ resource.ResourceWithIdentity    = &secretReferenceResource{}
 )
 
 // Supported manager_type values.
@@ -97,10 +99,11 @@ type secretReferenceResourceModel struct {
 	VaultAppRoleAuth    *vaultAppRoleAuthModel    `tfsdk:"vault_approle_auth"`
 	VaultKubernetesAuth *vaultKubernetesAuthModel `tfsdk:"vault_kubernetes_auth"`
 
-	Status    types.String `tfsdk:"status"`
-	CreatedBy types.String `tfsdk:"created_by"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	Status             types.String `tfsdk:"status"`
+	CreatedBy          types.String `tfsdk:"created_by"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 type awsAccessKeyAuthModel struct {
@@ -449,6 +452,16 @@ func (r *secretReferenceResource) Schema(_ context.Context, _ resource.SchemaReq
 				Description: "Timestamp when the secret reference was last updated.",
 				Computed:    true,
 			},
+			"deletion_protection": deletionProtectionAttribute(),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *secretReferenceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("Secret reference identifier (UUID)."),
 		},
 	}
 }
@@ -475,6 +488,11 @@ func (r *secretReferenceResource) Configure(_ context.Context, req resource.Conf
 // auth block family matches manager_type, allow_all_workspaces XOR allowed_workspaces,
 // allowed_workspaces transitions the API supports, and plain vs _wo credential rules.
 func (r *secretReferenceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDeletionProtectionPlan(ctx, req, resp, r.client, "Secret Reference")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Nothing to validate on destroy.
 	if req.Plan.Raw.IsNull() {
 		return
@@ -745,6 +763,7 @@ func (r *secretReferenceResource) Create(ctx context.Context, req resource.Creat
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -776,8 +795,14 @@ func (r *secretReferenceResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// deletion_protection is provider-side only; fill in the default after import.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(defaultDeletionProtection(r.client))
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -804,6 +829,21 @@ func (r *secretReferenceResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// deletion_protection is not sent to the API, so a change to only it
+	// keeps the rest of state.
+	if !planChangesAttributes(req.Plan, req.State,
+		"name", "description", "manager_type", "secret_path", "secret_key", "allow_all_workspaces", "allowed_workspaces", "tags", "auth_version",
+		attrAWSAccessKeyAuth, attrAWSAssumedRoleAuth, attrAWSServiceRoleAuth, attrAzureEntraAuth, attrAzureManagedAuth,
+		attrVaultTokenAuth, attrVaultAppRoleAuth, attrVaultKubernetesAuth,
+	) {
+		state.DeletionProtection = plan.DeletionProtection
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+		return
+	}
+
 	rotate := authVersionChanged(&plan, &state)
 	authConfig, authErr := buildAuthConfig(&plan, &config, rotate)
 	if authErr != nil {
@@ -871,6 +911,7 @@ func (r *secretReferenceResource) Update(ctx context.Context, req resource.Updat
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -882,6 +923,10 @@ func (r *secretReferenceResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	if !checkDeletionProtection(state.DeletionProtection, "Secret Reference", state.Slug.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
 	if err := r.client.DeleteSecretReference(ctx, state.Slug.ValueString()); err != nil {
 		// Already gone server-side — treat as successful delete.
 		if strings.Contains(err.Error(), "404") {
@@ -895,9 +940,22 @@ func (r *secretReferenceResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
-// ImportState imports the resource state by slug.
+// ImportState imports the resource state by slug, or by identity.
 func (r *secretReferenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	if req.ID == "" {
+		importSlugFromIdentity(ctx, req, resp, "secret reference", func(id, _ string) (string, error) {
+			secretRef, err := r.client.GetSecretReference(ctx, id)
+			if err != nil {
+				if client.IsNotFound(err) {
+					return "", nil
+				}
+				return "", err
+			}
+			return secretRef.Slug, nil
+		})
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), req.ID)...)
 }
 
 // authBlocksSetInConfig returns the non-null auth block attribute names in the given model.
@@ -34,6 +34,9 @@ type secretReferencesDataSourceModel struct {
 	ManagerType      types.String          `tfsdk:"manager_type"`
 	Search           types.String          `tfsdk:"search"`
 	SecretReferences []secretReferenceItem `tfsdk:"secret_references"`
+	Filter           []listFilterModel     `tfsdk:"filter"`
+	IDs              types.List            `tfsdk:"ids"`
+	Slugs            types.List            `tfsdk:"slugs"`
 }
 
 type secretReferenceItem struct {
@@ -108,6 +111,11 @@ func (d *secretReferencesDataSource) Schema(_ context.Context, _ datasource.Sche
 					},
 				},
 			},
+			"ids":   listIDsAttribute("secret references"),
+			"slugs": listSlugsAttribute("secret references"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("secret references"),
 		},
 	}
 }
@@ -184,6 +192,13 @@ func (d *secretReferencesDataSource) Read(ctx context.Context, req datasource.Re
 		}
 	}
 
+	state.SecretReferences = applyListFilters(state.SecretReferences, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.SecretReferences, "id")
+	state.Slugs = listItemStrings(state.SecretReferences, "slug")
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
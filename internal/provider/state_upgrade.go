@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Schema versions and state upgrades
+//
+// A resource whose schema changes in a way existing state cannot be read with
+// lists its upgrades as one slice of steps per prior schema version: the
+// steps at index N rewrite a version N state into version N+1. The schema
+// Version is the number of upgrades, and stateUpgraders turns the list into
+// the upgraders the framework expects. Terraform upgrades a state in a single
+// call from whatever version it was written with, so each upgrader applies
+// the steps of every version from its own up to the current one.
+//
+// Steps work on the state as decoded from its JSON. Numbers, strings and
+// booleans carry over unchanged, so a type change between Int64 and Float64
+// needs no step, and attributes the current schema no longer defines are
+// dropped when the result is decoded with it.
+
+// stateUpgradeStep rewrites the attributes of a state, or of an object
+// nested in it, into the shape of the next schema version.
+type stateUpgradeStep func(attrs map[string]interface{}) error
+
+// stateUpgraders returns the state upgraders for a resource whose upgrades,
+// indexed by the schema version they upgrade from, are given.
+func stateUpgraders(upgrades [][]stateUpgradeStep) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(upgrades))
+	for version := range upgrades {
+		var steps []stateUpgradeStep
+		for _, upgrade := range upgrades[version:] {
+			steps = append(steps, upgrade...)
+		}
+		upgraders[int64(version)] = jsonStateUpgrader(int64(version), steps...)
+	}
+	return upgraders
+}
+
+// jsonStateUpgrader returns a state upgrader that applies steps to the JSON
+// of a state written with schema version and decodes the result with the
+// current schema.
+func jsonStateUpgrader(version int64, steps ...stateUpgradeStep) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("The version %d state has no JSON data. Only states written by Terraform 0.12 and later can be upgraded.", version),
+				)
+				return
+			}
+
+			upgraded, err := upgradeStateJSON(req.RawState.JSON, steps)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("Could not upgrade the version %d state: %s", version, err),
+				)
+				return
+			}
+
+			rawState := tfprotov6.RawState{JSON: upgraded}
+			value, err := rawState.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("Could not decode the upgraded version %d state: %s", version, err),
+				)
+				return
+			}
+			resp.State.Raw = value
+		},
+	}
+}
+
+// upgradeStateJSON applies steps to the attributes of the state in data.
+// Numbers are kept as written so large integers do not lose precision.
+func upgradeStateJSON(data []byte, steps []stateUpgradeStep) ([]byte, error) {
+	var attrs map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&attrs); err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		if err := step(attrs); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(attrs)
+}
+
+// listElements applies steps to each object of the named list or set
+// attribute, such as a list nested attribute.
+func listElements(name string, steps ...stateUpgradeStep) stateUpgradeStep {
+	return func(attrs map[string]interface{}) error {
+		elements, _ := attrs[name].([]interface{})
+		for i, element := range elements {
+			object, ok := element.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, step := range steps {
+				if err := step(object); err != nil {
+					return fmt.Errorf("%s[%d]: %w", name, i, err)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// renameAttribute moves the value of attribute from to attribute to.
+func renameAttribute(from, to string) stateUpgradeStep {
+	return func(attrs map[string]interface{}) error {
+		if value, ok := attrs[from]; ok {
+			attrs[to] = value
+			delete(attrs, from)
+		}
+		return nil
+	}
+}
+
+// jsonStringAttribute replaces the JSON encoded string of the named attribute
+// with the value it encodes, for attributes that become nested attributes or
+// blocks. Empty strings become null.
+func jsonStringAttribute(name string) stateUpgradeStep {
+	return func(attrs map[string]interface{}) error {
+		s, ok := attrs[name].(string)
+		if !ok {
+			return nil
+		}
+		if s == "" {
+			attrs[name] = nil
+			return nil
+		}
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader([]byte(s)))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return fmt.Errorf("%s is not valid JSON: %w", name, err)
+		}
+		attrs[name] = value
+		return nil
+	}
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// upgradeState runs the upgrader for version from upgraders on a state with
+// the given raw JSON, decoding the result with s as the framework does.
+func upgradeState(t *testing.T, upgraders map[int64]resource.StateUpgrader, s schema.Schema, version int64, rawState string) resource.UpgradeStateResponse {
+	t.Helper()
+
+	upgrader, ok := upgraders[version]
+	if !ok {
+		t.Fatalf("no upgrader for version %d", version)
+	}
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: s}}
+	upgrader.StateUpgrader(context.Background(), resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	}, &resp)
+	return resp
+}
+
+// resourceSchema returns the schema of r.
+func resourceSchema(r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func TestStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Version: 2,
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"slug":        schema.StringAttribute{Computed: true},
+			"max_retries": schema.Int64Attribute{Optional: true},
+			"retry": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"attempts": schema.Int64Attribute{Optional: true},
+				},
+			},
+			"targets": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"provider_slug": schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+	upgraders := stateUpgraders([][]stateUpgradeStep{
+		// 0 → 1: name renamed to slug, targets[*].provider to provider_slug.
+		{renameAttribute("name", "slug"), listElements("targets", renameAttribute("provider", "provider_slug"))},
+		// 1 → 2: retry became a nested attribute.
+		{jsonStringAttribute("retry")},
+	})
+	if len(upgraders) != 2 {
+		t.Fatalf("got %d upgraders, want 2", len(upgraders))
+	}
+
+	for _, tc := range []struct {
+		name     string
+		version  int64
+		rawState string
+	}{
+		{
+			name:     "from version 0",
+			version:  0,
+			rawState: `{"id":"cfg-1","name":"routing","max_retries":9007199254740993,"retry":"{\"attempts\":3}","targets":[{"provider":"openai"}],"removed":true}`,
+		},
+		{
+			name:     "from version 1",
+			version:  1,
+			rawState: `{"id":"cfg-1","slug":"routing","max_retries":9007199254740993,"retry":"{\"attempts\":3}","targets":[{"provider_slug":"openai"}]}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := upgradeState(t, upgraders, s, tc.version, tc.rawState)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var slug, providerSlug types.String
+			var maxRetries, attempts types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("slug"), &slug)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("max_retries"), &maxRetries)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("retry").AtName("attempts"), &attempts)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("targets").AtListIndex(0).AtName("provider_slug"), &providerSlug)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading upgraded state: %v", resp.Diagnostics)
+			}
+			if slug.ValueString() != "routing" {
+				t.Errorf("slug = %q, want routing", slug.ValueString())
+			}
+			if maxRetries.ValueInt64() != 9007199254740993 {
+				t.Errorf("max_retries = %d, want 9007199254740993", maxRetries.ValueInt64())
+			}
+			if attempts.ValueInt64() != 3 {
+				t.Errorf("retry.attempts = %d, want 3", attempts.ValueInt64())
+			}
+			if providerSlug.ValueString() != "openai" {
+				t.Errorf("targets[0].provider_slug = %q, want openai", providerSlug.ValueString())
+			}
+		})
+	}
+
+	resp := upgradeState(t, upgraders, s, 1, `{"id":"cfg-1","retry":"{not json"}`)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for an invalid JSON string")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "retry is not valid JSON") {
+		t.Errorf("error %q does not name the attribute", detail)
+	}
+}
+
+func TestUpgradeState_workspaceUsageLimits(t *testing.T) {
+	ctx := context.Background()
+	const usageLimits = `"usage_limits":[{"type":"cost","credit_limit":100,"alert_threshold":80,"periodic_reset":"monthly"}],` +
+		`"rate_limits":[{"type":"requests","unit":"rpm","value":60}]`
+
+	for _, tc := range []struct {
+		name     string
+		target   resource.ResourceWithUpgradeState
+		rawState string
+	}{
+		{
+			name:     "workspace",
+			target:   NewWorkspaceResource().(resource.ResourceWithUpgradeState),
+			rawState: `{"id":"ws-1","name":"Production",` + usageLimits + `,"metadata":null,"created_at":"2025-01-01T00:00:00Z"}`,
+		},
+		{
+			name:     "integration workspace access",
+			target:   NewIntegrationWorkspaceAccessResource().(resource.ResourceWithUpgradeState),
+			rawState: `{"id":"int-1/ws-1","integration_id":"int-1","workspace_id":"ws-1","enabled":true,` + usageLimits + `}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := resourceSchema(tc.target)
+			if s.Version != 1 {
+				t.Errorf("schema version = %d, want 1", s.Version)
+			}
+
+			resp := upgradeState(t, tc.target.UpgradeState(ctx), s, 0, tc.rawState)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var limits types.List
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("usage_limits"), &limits)...)
+			var models []workspaceUsageLimitsModel
+			resp.Diagnostics.Append(limits.ElementsAs(ctx, &models, false)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading upgraded state: %v", resp.Diagnostics)
+			}
+			if len(models) != 1 {
+				t.Fatalf("got %d usage limits, want 1", len(models))
+			}
+			if got := models[0].CreditLimit.ValueFloat64(); got != 100 {
+				t.Errorf("credit_limit = %v, want 100", got)
+			}
+			if got := models[0].AlertThreshold.ValueFloat64(); got != 80 {
+				t.Errorf("alert_threshold = %v, want 80", got)
+			}
+			if got := models[0].PeriodicReset.ValueString(); got != "monthly" {
+				t.Errorf("periodic_reset = %q, want monthly", got)
+			}
+		})
+	}
+}
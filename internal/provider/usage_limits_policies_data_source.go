@@ -31,6 +31,8 @@ type usageLimitsPoliciesDataSource struct {
 type usageLimitsPoliciesDataSourceModel struct {
 	WorkspaceID types.String                    `tfsdk:"workspace_id"`
 	Policies    []usageLimitsPolicySummaryModel `tfsdk:"policies"`
+	Filter      []listFilterModel               `tfsdk:"filter"`
+	IDs         types.List                      `tfsdk:"ids"`
 }
 
 // usageLimitsPolicySummaryModel maps policy summary data.
@@ -119,6 +121,10 @@ func (d *usageLimitsPoliciesDataSource) Schema(_ context.Context, _ datasource.S
 					},
 				},
 			},
+			"ids": listIDsAttribute("policies"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("policies"),
 		},
 	}
 }
@@ -205,6 +211,12 @@ func (d *usageLimitsPoliciesDataSource) Read(ctx context.Context, req datasource
 		state.Policies = append(state.Policies, policyState)
 	}
 
+	state.Policies = applyListFilters(state.Policies, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Policies, "id")
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewUsageLimitsPolicyListResource lists usage limits policies for
+// `terraform query`.
+func NewUsageLimitsPolicyListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.UsageLimitsPolicy]{
+		typeName:       "usage_limits_policy",
+		kind:           "usage limits policies",
+		title:          "Usage Limits Policies",
+		workspaceScope: listWorkspaceOptional,
+		list: func(ctx context.Context, c *client.Client, workspaceID string) ([]client.UsageLimitsPolicy, error) {
+			return c.ListUsageLimitsPolicies(ctx, workspaceID)
+		},
+		fields: map[string]listResourceField[client.UsageLimitsPolicy]{
+			"name":   {"name", func(p client.UsageLimitsPolicy) string { return p.Name }},
+			"type":   {"type", func(p client.UsageLimitsPolicy) string { return p.Type }},
+			"status": {"status", func(p client.UsageLimitsPolicy) string { return p.Status }},
+		},
+		identity: func(p client.UsageLimitsPolicy, _ string) map[string]string {
+			return map[string]string{"id": p.ID}
+		},
+		displayName: func(p client.UsageLimitsPolicy) string { return p.Name },
+		resource:    NewUsageLimitsPolicyResource,
+	})
+}
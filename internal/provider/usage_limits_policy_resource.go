@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +20,8 @@ var (
 	_ resource.Resource                = &usageLimitsPolicyResource{}
 	_ resource.ResourceWithConfigure   = &usageLimitsPolicyResource{}
 	_ resource.ResourceWithImportState = &usageLimitsPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &usageLimitsPolicyResource{}
+	_ resource.ResourceWithIdentity    = &usageLimitsPolicyResource{}
 )
 
 // NewUsageLimitsPolicyResource is a helper function to simplify the provider implementation.
@@ -36,6 +39,7 @@ type usageLimitsPolicyResourceModel struct {
 	ID             types.String  `tfsdk:"id"`
 	Name           types.String  `tfsdk:"name"`
 	WorkspaceID    types.String  `tfsdk:"workspace_id"`
+	WorkspaceSlug  types.String  `tfsdk:"workspace_slug"`
 	Conditions     types.String  `tfsdk:"conditions"`
 	GroupBy        types.String  `tfsdk:"group_by"`
 	Type           types.String  `tfsdk:"type"`
@@ -71,10 +75,8 @@ func (r *usageLimitsPolicyResource) Schema(_ context.Context, _ resource.SchemaR
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID to create the policy in.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"conditions": schema.StringAttribute{
 				Description: "JSON array of conditions that define which requests the policy applies to. Each condition has 'key' and 'value'.",
 				Required:    true,
@@ -127,6 +129,15 @@ func (r *usageLimitsPolicyResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *usageLimitsPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("Usage limits policy identifier (UUID)."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *usageLimitsPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -147,6 +158,12 @@ func (r *usageLimitsPolicyResource) Configure(_ context.Context, req resource.Co
 	r.client = client
 }
 
+// ModifyPlan replaces the policy only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *usageLimitsPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *usageLimitsPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -224,6 +241,8 @@ func (r *usageLimitsPolicyResource) Create(ctx context.Context, req resource.Cre
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -262,6 +281,8 @@ func (r *usageLimitsPolicyResource) Read(ctx context.Context, req resource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -318,6 +339,8 @@ func (r *usageLimitsPolicyResource) Update(ctx context.Context, req resource.Upd
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -343,7 +366,7 @@ func (r *usageLimitsPolicyResource) Delete(ctx context.Context, req resource.Del
 
 // ImportState imports the resource state.
 func (r *usageLimitsPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // mapPolicyToState maps a UsageLimitsPolicy API response to the Terraform state model
@@ -405,21 +428,8 @@ func (r *usageLimitsPolicyResource) mapPolicyToState(state *usageLimitsPolicyRes
 
 // preserveJSONFormatting keeps user's JSON format if semantically equal
 func preserveJSONFormatting(oldJSON, newJSON string) types.String {
-	if oldJSON == "" {
-		return types.StringValue(newJSON)
+	if oldJSON != "" && jsonSemanticallyEqual(oldJSON, newJSON) {
+		return types.StringValue(oldJSON)
 	}
-
-	var oldVal, newVal interface{}
-	oldErr := json.Unmarshal([]byte(oldJSON), &oldVal)
-	newErr := json.Unmarshal([]byte(newJSON), &newVal)
-
-	if oldErr == nil && newErr == nil {
-		oldBytes, _ := json.Marshal(oldVal)
-		newBytes, _ := json.Marshal(newVal)
-		if string(oldBytes) == string(newBytes) {
-			return types.StringValue(oldJSON)
-		}
-	}
-
 	return types.StringValue(newJSON)
 }
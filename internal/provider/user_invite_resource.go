@@ -3,11 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +23,8 @@ var (
 	_ resource.Resource                = &userInviteResource{}
 	_ resource.ResourceWithConfigure   = &userInviteResource{}
 	_ resource.ResourceWithImportState = &userInviteResource{}
+	_ resource.ResourceWithIdentity    = &userInviteResource{}
+	_ resource.ResourceWithModifyPlan  = &userInviteResource{}
 )
 
 // NewUserInviteResource is a helper function to simplify the provider implementation.
@@ -39,6 +45,8 @@ type userInviteResourceModel struct {
 	Status     types.String `tfsdk:"status"`
 	Workspaces types.List   `tfsdk:"workspaces"`
 	Scopes     types.List   `tfsdk:"scopes"`
+	Reissue    types.Bool   `tfsdk:"reissue_on_expiry"`
+	Accepted   types.Bool   `tfsdk:"accepted"`
 	CreatedAt  types.String `tfsdk:"created_at"`
 	ExpiresAt  types.String `tfsdk:"expires_at"`
 }
@@ -81,7 +89,19 @@ func (r *userInviteResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"status": schema.StringAttribute{
-				Description: "Status of the invitation (e.g., 'pending', 'accepted', 'expired').",
+				Description: "Status of the invitation (e.g., 'pending', 'accepted', 'expired'). " +
+					"Reported as 'expired' once `expires_at` has passed, even if the API still says 'pending'.",
+				Computed: true,
+			},
+			"reissue_on_expiry": schema.BoolAttribute{
+				Description: "Plan a replacement invitation when a refresh finds this one expired or cancelled. " +
+					"When false, the dead invitation is kept in state. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"accepted": schema.BoolAttribute{
+				Description: "Whether the invitation has been accepted and the user has joined the organization.",
 				Computed:    true,
 			},
 			"workspaces": schema.ListNestedAttribute{
@@ -117,6 +137,15 @@ func (r *userInviteResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *userInviteResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("Invitation identifier."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *userInviteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -198,7 +227,9 @@ func (r *userInviteResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(invite.ID)
-	plan.Status = types.StringValue(invite.Status)
+	status, accepted := userInviteState(*invite, time.Now())
+	plan.Status = types.StringValue(status)
+	plan.Accepted = types.BoolValue(accepted)
 	plan.CreatedAt = types.StringValue(invite.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
 	plan.ExpiresAt = types.StringValue(invite.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
 
@@ -234,6 +265,7 @@ func (r *userInviteResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -248,6 +280,10 @@ func (r *userInviteResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Get refreshed invite value from Portkey
 	invite, err := r.client.GetUserInvite(ctx, state.ID.ValueString())
+	if err != nil && client.IsNotFound(err) {
+		r.readMissingInvite(ctx, &state, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Portkey User Invite",
@@ -263,7 +299,12 @@ func (r *userInviteResource) Read(ctx context.Context, req resource.ReadRequest,
 	if state.Role.IsNull() || state.Role.IsUnknown() {
 		state.Role = types.StringValue(invite.Role)
 	}
-	state.Status = types.StringValue(invite.Status)
+	status, accepted := userInviteState(*invite, time.Now())
+	state.Status = types.StringValue(status)
+	state.Accepted = types.BoolValue(accepted)
+	if state.Reissue.IsNull() {
+		state.Reissue = types.BoolValue(true)
+	}
 	state.CreatedAt = types.StringValue(invite.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
 	state.ExpiresAt = types.StringValue(invite.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
 
@@ -299,15 +340,59 @@ func (r *userInviteResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// ModifyPlan plans a replacement invitation when the refreshed state shows the
+// current one has expired or been cancelled and reissue_on_expiry is set.
+func (r *userInviteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to replace on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state userInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Reissue.ValueBool() || !userInviteNeedsReissue(state.Status.ValueString()) {
+		return
+	}
+
+	// Terraform only honours RequiresReplace for attributes whose planned
+	// value differs from state, so the status is marked as unknown.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("status"))
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
+// Only reissue_on_expiry can change in place; the invitation itself cannot be
+// updated.
 func (r *userInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// User invites cannot be updated - they must be deleted and recreated
-	resp.Diagnostics.AddError(
-		"User Invite Update Not Supported",
-		"User invitations cannot be updated. Please delete and recreate the invitation with the new configuration.",
-	)
+	if !plan.Workspaces.Equal(state.Workspaces) || !plan.Scopes.Equal(state.Scopes) {
+		resp.Diagnostics.AddError(
+			"User Invite Update Not Supported",
+			"User invitations cannot be updated. Please delete and recreate the invitation with the new configuration.",
+		)
+		return
+	}
+
+	state.Reissue = plan.Reissue
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -321,8 +406,9 @@ func (r *userInviteResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete user invitation
+	// Accepted invitations may already be gone.
 	err := r.client.DeleteUserInvite(ctx, state.ID.ValueString())
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Portkey User Invite",
 			"Could not delete user invitation, unexpected error: "+err.Error(),
@@ -334,5 +420,50 @@ func (r *userInviteResource) Delete(ctx context.Context, req resource.DeleteRequ
 // ImportState imports the resource state.
 func (r *userInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// readMissingInvite handles an invitation the API no longer returns. Accepted
+// invitations can be removed once the user joins, so the invitation is kept
+// and marked accepted when a user with its email exists; otherwise it is
+// removed from state and recreated on the next apply.
+func (r *userInviteResource) readMissingInvite(ctx context.Context, state *userInviteResourceModel, resp *resource.ReadResponse) {
+	if state.Email.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	_, err := r.client.GetUserByEmail(ctx, state.Email.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Portkey User Invite",
+			"Could not look up user "+state.Email.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Status = types.StringValue("accepted")
+	state.Accepted = types.BoolValue(true)
+	if state.Reissue.IsNull() {
+		state.Reissue = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// userInviteState returns the status to record for an invitation and whether
+// it has been accepted. Pending invitations past their expiry are reported as
+// expired.
+func userInviteState(inv client.UserInvite, now time.Time) (string, bool) {
+	if strings.EqualFold(inv.Status, "accepted") {
+		return inv.Status, true
+	}
+	if userInviteExpired(inv, now) {
+		return userInviteStatusExpired, false
+	}
+	return inv.Status, false
 }
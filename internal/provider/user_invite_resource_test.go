@@ -3,11 +3,39 @@ package provider
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 )
 
+func TestUserInviteState(t *testing.T) {
+	now := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name         string
+		invite       client.UserInvite
+		wantStatus   string
+		wantAccepted bool
+	}{
+		{"pending", client.UserInvite{Status: "pending", ExpiresAt: future}, "pending", false},
+		{"past expiry", client.UserInvite{Status: "pending", ExpiresAt: past}, "expired", false},
+		{"expired status", client.UserInvite{Status: "expired", ExpiresAt: future}, "expired", false},
+		{"accepted after expiry", client.UserInvite{Status: "accepted", ExpiresAt: past}, "accepted", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, accepted := userInviteState(tt.invite, now)
+			if status != tt.wantStatus || accepted != tt.wantAccepted {
+				t.Errorf("userInviteState() = (%q, %v), want (%q, %v)", status, accepted, tt.wantStatus, tt.wantAccepted)
+			}
+		})
+	}
+}
+
 func TestAccUserInviteResource_basic(t *testing.T) {
 	rEmail := fmt.Sprintf("tf-acc-test-%s@example.com", acctest.RandString(8))
 
@@ -24,6 +52,8 @@ func TestAccUserInviteResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("portkey_user_invite.test", "role", "member"),
 					resource.TestCheckResourceAttrSet("portkey_user_invite.test", "created_at"),
 					resource.TestCheckResourceAttrSet("portkey_user_invite.test", "expires_at"),
+					resource.TestCheckResourceAttr("portkey_user_invite.test", "accepted", "false"),
+					resource.TestCheckResourceAttr("portkey_user_invite.test", "reissue_on_expiry", "true"),
 				),
 			},
 			// ImportState testing
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &userInvitesDataSource{}
+	_ datasource.DataSourceWithConfigure = &userInvitesDataSource{}
+)
+
+// NewUserInvitesDataSource is a helper function to simplify the provider implementation.
+func NewUserInvitesDataSource() datasource.DataSource {
+	return &userInvitesDataSource{}
+}
+
+// userInvitesDataSource is the data source implementation.
+type userInvitesDataSource struct {
+	client *client.Client
+}
+
+// userInvitesDataSourceModel maps the data source schema data.
+type userInvitesDataSourceModel struct {
+	Role          types.String          `tfsdk:"role"`
+	Status        types.String          `tfsdk:"status"`
+	EmailContains types.String          `tfsdk:"email_contains"`
+	Expired       types.Bool            `tfsdk:"expired"`
+	Invites       []userInviteDataModel `tfsdk:"invites"`
+	Filter        []listFilterModel     `tfsdk:"filter"`
+	IDs           types.List            `tfsdk:"ids"`
+}
+
+// userInviteDataModel maps a single user invite.
+type userInviteDataModel struct {
+	ID         types.String `tfsdk:"id"`
+	Email      types.String `tfsdk:"email"`
+	Role       types.String `tfsdk:"role"`
+	Status     types.String `tfsdk:"status"`
+	Expired    types.Bool   `tfsdk:"expired"`
+	Workspaces types.List   `tfsdk:"workspaces"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// userInviteWorkspaceObjectType is the element type of the workspaces list.
+var userInviteWorkspaceObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"role": types.StringType,
+	},
+}
+
+// Metadata returns the data source type name.
+func (d *userInvitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_invites"
+}
+
+// Schema defines the schema for the data source.
+func (d *userInvitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Portkey user invitations. Pages through the API until every invite is returned; " +
+			"filters are applied to the full list.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Description: "Only return invites for this organization role: `admin` or `member`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "member"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return invites with this status (for example `pending`).",
+				Optional:    true,
+			},
+			"email_contains": schema.StringAttribute{
+				Description: "Only return invites whose email contains this string (case-insensitive).",
+				Optional:    true,
+			},
+			"expired": schema.BoolAttribute{
+				Description: "When true, only return expired invites; when false, only return invites that have not expired. " +
+					"Returns both when unset.",
+				Optional: true,
+			},
+			"invites": schema.ListNestedAttribute{
+				Description: "Invites matching the filters, ordered as returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Invite identifier.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address the invite was sent to.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Organization role granted on acceptance.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the invite.",
+							Computed:    true,
+						},
+						"expired": schema.BoolAttribute{
+							Description: "Whether the invite has expired, either by status or because expires_at has passed.",
+							Computed:    true,
+						},
+						"workspaces": schema.ListNestedAttribute{
+							Description: "Workspaces the user is added to on acceptance.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Workspace ID.",
+										Computed:    true,
+									},
+									"role": schema.StringAttribute{
+										Description: "Workspace role.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the invite was created.",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "Timestamp when the invite expires.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ids": listIDsAttribute("invites"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("invites"),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *userInvitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userInvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state userInvitesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invites, err := d.client.ListUserInvites(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Portkey User Invites",
+			err.Error(),
+		)
+		return
+	}
+
+	filter := userInviteFilter{
+		Role:          state.Role.ValueString(),
+		Status:        state.Status.ValueString(),
+		EmailContains: state.EmailContains.ValueString(),
+	}
+	if !state.Expired.IsNull() && !state.Expired.IsUnknown() {
+		expired := state.Expired.ValueBool()
+		filter.Expired = &expired
+	}
+
+	now := time.Now()
+	state.Invites = []userInviteDataModel{}
+	for _, inv := range filterUserInvites(invites, filter, now) {
+		item := userInviteDataModel{
+			ID:      types.StringValue(inv.ID),
+			Email:   types.StringValue(inv.Email),
+			Role:    types.StringValue(inv.Role),
+			Status:  types.StringValue(inv.Status),
+			Expired: types.BoolValue(userInviteExpired(inv, now)),
+		}
+
+		workspaces := make([]attr.Value, 0, len(inv.Workspaces))
+		for _, ws := range inv.Workspaces {
+			obj, diags := types.ObjectValue(userInviteWorkspaceObjectType.AttrTypes, map[string]attr.Value{
+				"id":   types.StringValue(ws.ID),
+				"role": types.StringValue(ws.Role),
+			})
+			resp.Diagnostics.Append(diags...)
+			workspaces = append(workspaces, obj)
+		}
+		list, diags := types.ListValue(userInviteWorkspaceObjectType, workspaces)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		item.Workspaces = list
+
+		if !inv.CreatedAt.IsZero() {
+			item.CreatedAt = types.StringValue(inv.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+		} else {
+			item.CreatedAt = types.StringNull()
+		}
+		if !inv.ExpiresAt.IsZero() {
+			item.ExpiresAt = types.StringValue(inv.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
+		} else {
+			item.ExpiresAt = types.StringNull()
+		}
+
+		state.Invites = append(state.Invites, item)
+	}
+
+	state.Invites = applyListFilters(state.Invites, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Invites, "id")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// userInviteFilter holds the optional filters of the user invites data
+// source. Empty strings and a nil Expired match everything.
+type userInviteFilter struct {
+	Role          string
+	Status        string
+	EmailContains string
+	Expired       *bool
+}
+
+// userInviteExpired reports whether an invite has expired, either because the
+// API says so or because its expiry time has passed.
+func userInviteExpired(inv client.UserInvite, now time.Time) bool {
+	if strings.EqualFold(inv.Status, "expired") {
+		return true
+	}
+	return !inv.ExpiresAt.IsZero() && inv.ExpiresAt.Before(now)
+}
+
+// filterUserInvites returns the invites matching f.
+func filterUserInvites(invites []client.UserInvite, f userInviteFilter, now time.Time) []client.UserInvite {
+	needle := strings.ToLower(f.EmailContains)
+	result := make([]client.UserInvite, 0, len(invites))
+	for _, inv := range invites {
+		if f.Role != "" && inv.Role != f.Role {
+			continue
+		}
+		if f.Status != "" && !strings.EqualFold(inv.Status, f.Status) {
+			continue
+		}
+		if needle != "" && !strings.Contains(strings.ToLower(inv.Email), needle) {
+			continue
+		}
+		if f.Expired != nil && userInviteExpired(inv, now) != *f.Expired {
+			continue
+		}
+		result = append(result, inv)
+	}
+	return result
+}
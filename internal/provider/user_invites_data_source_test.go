@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+func TestFilterUserInvites(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	invites := []client.UserInvite{
+		{ID: "inv-1", Email: "Alice@Example.com", Role: "admin", Status: "pending", ExpiresAt: now.Add(24 * time.Hour)},
+		{ID: "inv-2", Email: "bob@example.com", Role: "member", Status: "pending", ExpiresAt: now.Add(-time.Hour)},
+		{ID: "inv-3", Email: "carol@other.org", Role: "member", Status: "expired"},
+	}
+	yes, no := true, false
+
+	tests := []struct {
+		name   string
+		filter userInviteFilter
+		want   []string
+	}{
+		{name: "no filters", want: []string{"inv-1", "inv-2", "inv-3"}},
+		{name: "role", filter: userInviteFilter{Role: "member"}, want: []string{"inv-2", "inv-3"}},
+		{name: "status", filter: userInviteFilter{Status: "pending"}, want: []string{"inv-1", "inv-2"}},
+		{name: "email substring is case-insensitive", filter: userInviteFilter{EmailContains: "@EXAMPLE"}, want: []string{"inv-1", "inv-2"}},
+		{name: "expired by time or status", filter: userInviteFilter{Expired: &yes}, want: []string{"inv-2", "inv-3"}},
+		{name: "not expired", filter: userInviteFilter{Expired: &no}, want: []string{"inv-1"}},
+		{name: "combined", filter: userInviteFilter{Role: "member", Status: "pending", Expired: &yes}, want: []string{"inv-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterUserInvites(invites, tt.filter, now)
+			var ids []string
+			for _, inv := range got {
+				ids = append(ids, inv.ID)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccUserInvitesDataSource_emailFilter(t *testing.T) {
+	rEmail := fmt.Sprintf("tf-acc-invites-%s@example.com", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserInvitesDataSourceConfig(rEmail),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.portkey_user_invites.test", "invites.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.portkey_user_invites.test", "invites.0.id",
+						"portkey_user_invite.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.portkey_user_invites.test", "invites.0.role", "member"),
+					resource.TestCheckResourceAttr("data.portkey_user_invites.test", "invites.0.expired", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserInvitesDataSourceConfig(email string) string {
+	return testAccUserInviteResourceConfig(email, "member") + `
+data "portkey_user_invites" "test" {
+  email_contains = portkey_user_invite.test.email
+  expired        = false
+}
+`
+}
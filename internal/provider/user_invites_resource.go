@@ -0,0 +1,593 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &userInvitesResource{}
+	_ resource.ResourceWithConfigure  = &userInvitesResource{}
+	_ resource.ResourceWithModifyPlan = &userInvitesResource{}
+)
+
+// Per-email statuses reported by portkey_user_invites in addition to the
+// invite statuses returned by the API.
+const (
+	userInviteStatusJoined  = "joined"
+	userInviteStatusExpired = "expired"
+	userInviteStatusMissing = "missing"
+)
+
+// NewUserInvitesResource is a helper function to simplify the provider implementation.
+func NewUserInvitesResource() resource.Resource {
+	return &userInvitesResource{}
+}
+
+// userInvitesResource is the resource implementation.
+type userInvitesResource struct {
+	client *client.Client
+}
+
+// userInvitesResourceModel maps the resource schema data.
+type userInvitesResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Invites types.Map    `tfsdk:"invites"`
+	Status  types.Map    `tfsdk:"status"`
+}
+
+// userInvitesEntryModel maps a single entry of the invites map.
+type userInvitesEntryModel struct {
+	Role       types.String           `tfsdk:"role"`
+	Workspaces []workspaceInviteModel `tfsdk:"workspaces"`
+	Scopes     types.List             `tfsdk:"scopes"`
+}
+
+// userInvitesStatusModel maps a single entry of the status map.
+type userInvitesStatusModel struct {
+	InviteID  types.String `tfsdk:"invite_id"`
+	Status    types.String `tfsdk:"status"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// userInvitesStatusObjectType is the element type of the status map.
+var userInvitesStatusObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"invite_id":  types.StringType,
+		"status":     types.StringType,
+		"expires_at": types.StringType,
+	},
+}
+
+// userInviteSpec is the desired invite for one email address.
+type userInviteSpec struct {
+	Role       string
+	Workspaces []client.WorkspaceInviteDetails
+	Scopes     []string
+}
+
+// Metadata returns the resource type name.
+func (r *userInvitesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_invites"
+}
+
+// Schema defines the schema for the resource.
+func (r *userInvitesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manages Portkey user invitations for a set of email addresses.
+
+Each apply compares ` + "`invites`" + ` with the organization's users and pending invitations. People who have already
+joined are skipped, missing or expired invitations are (re)sent, and invitations whose role, workspaces or scopes
+changed are cancelled and sent again. Removing an email cancels its pending invitation. Invitations for emails not
+in ` + "`invites`" + ` are left alone.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invites": schema.MapNestedAttribute{
+				Description: "Invitations to send, keyed by email address.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "Organization role for the user: `admin` or `member`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("admin", "member"),
+							},
+						},
+						"workspaces": schema.ListNestedAttribute{
+							Description: "Workspaces to add the user to with specific roles.",
+							Optional:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Workspace ID.",
+										Required:    true,
+									},
+									"role": schema.StringAttribute{
+										Description: "Role in the workspace (e.g., 'admin', 'member', 'manager').",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"scopes": schema.ListAttribute{
+							Description: "API scopes to grant to the user's workspace API key.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"status": schema.MapNestedAttribute{
+				Description: "Invitation status for each email in `invites`.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"invite_id": schema.StringAttribute{
+							Description: "ID of the current invitation. Null when the user joined without one.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "`joined` when the user is in the organization, `expired` or `missing` when the invitation " +
+								"will be re-sent on the next apply, otherwise the invitation status reported by the API (e.g. `pending`).",
+							Computed: true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "Timestamp when the current invitation expires.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userInvitesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan schedules an update when a refresh found expired or missing
+// invitations, so they are re-sent even though the configuration is unchanged.
+func (r *userInvitesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state userInvitesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Invites.IsUnknown() {
+		return
+	}
+
+	desired := userInviteSpecsFromMap(ctx, plan.Invites, &resp.Diagnostics)
+	statuses := userInviteStatusesFromMap(ctx, state.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var reissue []string
+	for _, email := range sortedInviteEmails(statuses) {
+		if _, ok := desired[email]; ok && userInviteNeedsReissue(statuses[email].Status.ValueString()) {
+			reissue = append(reissue, email)
+		}
+	}
+	if len(reissue) == 0 {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.MapUnknown(userInvitesStatusObjectType))...)
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("invites"),
+		"User Invitations Will Be Re-sent",
+		"The invitations for the following emails have expired, been cancelled or no longer exist, and will be sent again: "+
+			strings.Join(reissue, ", "),
+	)
+}
+
+// Create sends the configured invitations and sets the initial Terraform state.
+func (r *userInvitesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userInvitesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Status = r.reconcile(ctx, plan, nil, types.MapNull(userInvitesStatusObjectType), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue("user_invites")
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the per-email invitation status.
+func (r *userInvitesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userInvitesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired := userInviteSpecsFromMap(ctx, state.Invites, &resp.Diagnostics)
+	previous := userInviteStatusesFromMap(ctx, state.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invites, joined := r.listInvitesAndUsers(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Status = userInviteStatusMap(ctx, desired, managedInviteIDs(previous), invites, joined, time.Now(), &resp.Diagnostics)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update re-sends changed, expired or missing invitations and cancels the
+// invitations of removed emails.
+func (r *userInvitesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userInvitesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous := userInviteSpecsFromMap(ctx, state.Invites, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Status = r.reconcile(ctx, plan, previous, state.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete cancels every invitation managed by this resource that has not been
+// accepted yet.
+func (r *userInvitesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userInvitesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statuses := userInviteStatusesFromMap(ctx, state.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, email := range sortedInviteEmails(statuses) {
+		s := statuses[email]
+		if s.InviteID.ValueString() == "" || strings.EqualFold(s.Status.ValueString(), "accepted") ||
+			s.Status.ValueString() == userInviteStatusJoined {
+			continue
+		}
+		err := r.client.DeleteUserInvite(ctx, s.InviteID.ValueString())
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Portkey User Invite",
+				"Could not cancel the invitation for "+email+": "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// reconcile sends and cancels invitations so the organization matches
+// plan.Invites, then returns the refreshed status map.
+func (r *userInvitesResource) reconcile(ctx context.Context, plan userInvitesResourceModel, previous map[string]userInviteSpec, previousStatus types.Map, diags *diag.Diagnostics) types.Map {
+	desired := userInviteSpecsFromMap(ctx, plan.Invites, diags)
+	statuses := userInviteStatusesFromMap(ctx, previousStatus, diags)
+	if diags.HasError() {
+		return types.MapNull(userInvitesStatusObjectType)
+	}
+	managed := managedInviteIDs(statuses)
+
+	invites, joined := r.listInvitesAndUsers(ctx, diags)
+	if diags.HasError() {
+		return types.MapNull(userInvitesStatusObjectType)
+	}
+
+	diff := diffUserInvites(desired, previous, managed, invites, joined, time.Now())
+
+	for _, id := range diff.Cancel {
+		err := r.client.DeleteUserInvite(ctx, id)
+		if err != nil && !client.IsNotFound(err) {
+			diags.AddError(
+				"Error Deleting Portkey User Invite",
+				"Could not cancel invitation "+id+": "+err.Error(),
+			)
+			return types.MapNull(userInvitesStatusObjectType)
+		}
+	}
+
+	for _, email := range diff.Send {
+		spec := desired[email]
+		inviteReq := client.CreateUserInviteRequest{
+			Email:      email,
+			Role:       spec.Role,
+			Workspaces: spec.Workspaces,
+		}
+		if len(spec.Scopes) > 0 {
+			inviteReq.WorkspaceAPIKeyDetails = &client.APIKeyDetails{Scopes: spec.Scopes}
+		}
+
+		invite, err := r.client.InviteUser(ctx, inviteReq)
+		if err != nil {
+			diags.AddError(
+				"Error Creating Portkey User Invite",
+				"Could not invite "+email+": "+err.Error(),
+			)
+			return types.MapNull(userInvitesStatusObjectType)
+		}
+		managed[email] = invite.ID
+	}
+
+	// Read everything back so the reported statuses reflect the API.
+	invites, joined = r.listInvitesAndUsers(ctx, diags)
+	if diags.HasError() {
+		return types.MapNull(userInvitesStatusObjectType)
+	}
+	return userInviteStatusMap(ctx, desired, managed, invites, joined, time.Now(), diags)
+}
+
+// listInvitesAndUsers returns every invitation in the organization and the
+// lower-cased emails of every user who has joined.
+func (r *userInvitesResource) listInvitesAndUsers(ctx context.Context, diags *diag.Diagnostics) ([]client.UserInvite, map[string]bool) {
+	invites, err := r.client.ListUserInvites(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Portkey User Invites",
+			"Could not list user invitations: "+err.Error(),
+		)
+		return nil, nil
+	}
+
+	users, err := r.client.ListUsers(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Portkey Users",
+			"Could not list users: "+err.Error(),
+		)
+		return nil, nil
+	}
+
+	joined := make(map[string]bool, len(users))
+	for _, u := range users {
+		joined[strings.ToLower(u.Email)] = true
+	}
+	return invites, joined
+}
+
+// userInvitesDiff holds the invitations to cancel (by ID) and the emails to
+// invite, both sorted.
+type userInvitesDiff struct {
+	Cancel []string
+	Send   []string
+}
+
+// diffUserInvites computes the invitations to cancel and send so that every
+// email in desired either belongs to a joined user or has a live invitation
+// matching its spec. previous holds the specs last applied and managed the
+// invitation IDs last recorded, both keyed by email.
+func diffUserInvites(desired, previous map[string]userInviteSpec, managed map[string]string, invites []client.UserInvite, joined map[string]bool, now time.Time) userInvitesDiff {
+	var diff userInvitesDiff
+
+	for _, email := range sortedInviteEmails(desired) {
+		if joined[strings.ToLower(email)] {
+			continue
+		}
+
+		spec := desired[email]
+		inv := findUserInvite(invites, email, managed[email])
+		switch {
+		case inv == nil:
+			diff.Send = append(diff.Send, email)
+		case userInviteExpired(*inv, now) || strings.EqualFold(inv.Status, "cancelled"):
+			diff.Cancel = append(diff.Cancel, inv.ID)
+			diff.Send = append(diff.Send, email)
+		case strings.EqualFold(inv.Status, "accepted"):
+			// The user accepted but has since left the organization; do not
+			// invite them back automatically.
+		case inv.Role != spec.Role || userInviteSpecChanged(previous, email, spec):
+			diff.Cancel = append(diff.Cancel, inv.ID)
+			diff.Send = append(diff.Send, email)
+		}
+	}
+
+	for _, email := range sortedInviteEmails(previous) {
+		if _, ok := desired[email]; ok {
+			continue
+		}
+		inv := findUserInvite(invites, email, managed[email])
+		if inv != nil && !strings.EqualFold(inv.Status, "accepted") {
+			diff.Cancel = append(diff.Cancel, inv.ID)
+		}
+	}
+
+	sort.Strings(diff.Cancel)
+	return diff
+}
+
+// userInviteSpecChanged reports whether email was previously applied with a
+// different spec. Scopes are not returned by the API, so a change can only be
+// detected against the previous configuration.
+func userInviteSpecChanged(previous map[string]userInviteSpec, email string, spec userInviteSpec) bool {
+	prev, ok := previous[email]
+	return ok && !reflect.DeepEqual(prev, spec)
+}
+
+// findUserInvite returns the invitation with ID id, falling back to the
+// newest invitation for email.
+func findUserInvite(invites []client.UserInvite, email, id string) *client.UserInvite {
+	var found *client.UserInvite
+	for i := range invites {
+		if id != "" && invites[i].ID == id {
+			return &invites[i]
+		}
+		if strings.EqualFold(invites[i].Email, email) && (found == nil || invites[i].CreatedAt.After(found.CreatedAt)) {
+			found = &invites[i]
+		}
+	}
+	return found
+}
+
+// userInviteStatus computes the reported status of email.
+func userInviteStatus(email, id string, invites []client.UserInvite, joined map[string]bool, now time.Time) userInvitesStatusModel {
+	status := userInvitesStatusModel{
+		InviteID:  types.StringNull(),
+		Status:    types.StringValue(userInviteStatusMissing),
+		ExpiresAt: types.StringNull(),
+	}
+
+	inv := findUserInvite(invites, email, id)
+	if inv != nil {
+		status.InviteID = types.StringValue(inv.ID)
+		status.Status = types.StringValue(inv.Status)
+		if userInviteExpired(*inv, now) {
+			status.Status = types.StringValue(userInviteStatusExpired)
+		}
+		if !inv.ExpiresAt.IsZero() {
+			status.ExpiresAt = types.StringValue(inv.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"))
+		}
+	}
+
+	if joined[strings.ToLower(email)] {
+		status.Status = types.StringValue(userInviteStatusJoined)
+	}
+	return status
+}
+
+// userInviteNeedsReissue reports whether an email with this status gets a new
+// invitation on the next apply.
+func userInviteNeedsReissue(status string) bool {
+	return status == userInviteStatusExpired || status == userInviteStatusMissing || strings.EqualFold(status, "cancelled")
+}
+
+// userInviteStatusMap builds the status attribute for every email in desired.
+func userInviteStatusMap(ctx context.Context, desired map[string]userInviteSpec, managed map[string]string, invites []client.UserInvite, joined map[string]bool, now time.Time, diags *diag.Diagnostics) types.Map {
+	statuses := make(map[string]userInvitesStatusModel, len(desired))
+	for email := range desired {
+		statuses[email] = userInviteStatus(email, managed[email], invites, joined, now)
+	}
+
+	result, d := types.MapValueFrom(ctx, userInvitesStatusObjectType, statuses)
+	diags.Append(d...)
+	return result
+}
+
+// userInviteSpecsFromMap converts the invites attribute into specs keyed by email.
+func userInviteSpecsFromMap(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]userInviteSpec {
+	specs := map[string]userInviteSpec{}
+	if m.IsNull() || m.IsUnknown() {
+		return specs
+	}
+
+	var entries map[string]userInvitesEntryModel
+	diags.Append(m.ElementsAs(ctx, &entries, false)...)
+	if diags.HasError() {
+		return specs
+	}
+
+	for email, entry := range entries {
+		spec := userInviteSpec{Role: entry.Role.ValueString()}
+		for _, ws := range entry.Workspaces {
+			spec.Workspaces = append(spec.Workspaces, client.WorkspaceInviteDetails{
+				ID:   ws.ID.ValueString(),
+				Role: ws.Role.ValueString(),
+			})
+		}
+		if !entry.Scopes.IsNull() && !entry.Scopes.IsUnknown() {
+			diags.Append(entry.Scopes.ElementsAs(ctx, &spec.Scopes, false)...)
+		}
+		specs[email] = spec
+	}
+	return specs
+}
+
+// userInviteStatusesFromMap converts the status attribute into models keyed by email.
+func userInviteStatusesFromMap(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]userInvitesStatusModel {
+	statuses := map[string]userInvitesStatusModel{}
+	if m.IsNull() || m.IsUnknown() {
+		return statuses
+	}
+	diags.Append(m.ElementsAs(ctx, &statuses, false)...)
+	return statuses
+}
+
+// managedInviteIDs returns the recorded invitation ID of each email.
+func managedInviteIDs(statuses map[string]userInvitesStatusModel) map[string]string {
+	ids := make(map[string]string, len(statuses))
+	for email, s := range statuses {
+		if id := s.InviteID.ValueString(); id != "" {
+			ids[email] = id
+		}
+	}
+	return ids
+}
+
+// sortedInviteEmails returns the keys of m in sorted order.
+func sortedInviteEmails[V any](m map[string]V) []string {
+	emails := make([]string, 0, len(m))
+	for email := range m {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+	return emails
+}
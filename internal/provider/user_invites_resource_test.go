@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+func TestDiffUserInvites(t *testing.T) {
+	now := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	later := now.Add(48 * time.Hour)
+	earlier := now.Add(-48 * time.Hour)
+
+	desired := map[string]userInviteSpec{
+		"joined@example.com":   {Role: "member"},
+		"new@example.com":      {Role: "member"},
+		"pending@example.com":  {Role: "member"},
+		"expired@example.com":  {Role: "member"},
+		"promoted@example.com": {Role: "admin"},
+		"scoped@example.com":   {Role: "member", Scopes: []string{"completions.write"}},
+	}
+	previous := map[string]userInviteSpec{
+		"pending@example.com":  {Role: "member"},
+		"expired@example.com":  {Role: "member"},
+		"promoted@example.com": {Role: "member"},
+		"scoped@example.com":   {Role: "member"},
+		"removed@example.com":  {Role: "member"},
+	}
+	managed := map[string]string{
+		"pending@example.com": "inv-pending",
+		"removed@example.com": "inv-removed",
+	}
+	invites := []client.UserInvite{
+		{ID: "inv-pending", Email: "pending@example.com", Role: "member", Status: "pending", ExpiresAt: later},
+		{ID: "inv-expired", Email: "Expired@example.com", Role: "member", Status: "pending", ExpiresAt: earlier},
+		{ID: "inv-promoted", Email: "promoted@example.com", Role: "member", Status: "pending", ExpiresAt: later},
+		{ID: "inv-scoped", Email: "scoped@example.com", Role: "member", Status: "pending", ExpiresAt: later},
+		{ID: "inv-removed", Email: "removed@example.com", Role: "member", Status: "pending", ExpiresAt: later},
+	}
+	joined := map[string]bool{"joined@example.com": true}
+
+	got := diffUserInvites(desired, previous, managed, invites, joined, now)
+
+	wantCancel := []string{"inv-expired", "inv-promoted", "inv-removed", "inv-scoped"}
+	wantSend := []string{"expired@example.com", "new@example.com", "promoted@example.com", "scoped@example.com"}
+
+	if !reflect.DeepEqual(got.Cancel, wantCancel) {
+		t.Errorf("Cancel = %v, want %v", got.Cancel, wantCancel)
+	}
+	if !reflect.DeepEqual(got.Send, wantSend) {
+		t.Errorf("Send = %v, want %v", got.Send, wantSend)
+	}
+}
+
+func TestUserInviteStatus(t *testing.T) {
+	now := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	invites := []client.UserInvite{
+		{ID: "inv-1", Email: "a@example.com", Status: "pending", ExpiresAt: now.Add(-time.Hour)},
+		{ID: "inv-2", Email: "b@example.com", Status: "pending", ExpiresAt: now.Add(time.Hour)},
+	}
+	joined := map[string]bool{"c@example.com": true}
+
+	tests := map[string]string{
+		"a@example.com": userInviteStatusExpired,
+		"b@example.com": "pending",
+		"c@example.com": userInviteStatusJoined,
+		"d@example.com": userInviteStatusMissing,
+	}
+	for email, want := range tests {
+		got := userInviteStatus(email, "", invites, joined, now)
+		if got.Status.ValueString() != want {
+			t.Errorf("status of %s = %q, want %q", email, got.Status.ValueString(), want)
+		}
+	}
+}
+
+func TestAccUserInvitesResource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-invites")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserInvitesResourceConfig(rName, "member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_user_invites.test", "status.%", "2"),
+					resource.TestCheckResourceAttr("portkey_user_invites.test", "status."+rName+"-1@example.com.status", "pending"),
+					resource.TestCheckResourceAttrSet("portkey_user_invites.test", "status."+rName+"-2@example.com.invite_id"),
+				),
+			},
+			// Changing the role re-sends both invitations.
+			{
+				Config: testAccUserInvitesResourceConfig(rName, "admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_user_invites.test", "invites."+rName+"-1@example.com.role", "admin"),
+					resource.TestCheckResourceAttr("portkey_user_invites.test", "status."+rName+"-1@example.com.status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserInvitesResourceConfig(prefix, role string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_user_invites" "test" {
+  invites = {
+    "%[1]s-1@example.com" = { role = %[2]q }
+    "%[1]s-2@example.com" = { role = %[2]q }
+  }
+}
+`, prefix, role)
+}
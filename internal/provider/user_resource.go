@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithConfigure   = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithIdentity    = &userResource{}
+)
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userResource is the resource implementation.
+type userResource struct {
+	client *client.Client
+}
+
+// userResourceModel maps the resource schema data.
+type userResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	UserID          types.String `tfsdk:"user_id"`
+	Email           types.String `tfsdk:"email"`
+	Role            types.String `tfsdk:"role"`
+	RemoveOnDestroy types.Bool   `tfsdk:"remove_on_destroy"`
+	FirstName       types.String `tfsdk:"first_name"`
+	LastName        types.String `tfsdk:"last_name"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manages an existing Portkey organization user.
+
+Users cannot be created through the Admin API; they join by accepting a ` + "`portkey_user_invite`" + `. This resource adopts a
+user who has already joined, identified by ` + "`user_id`" + ` or ` + "`email`" + `, and manages their organization role.
+Set ` + "`remove_on_destroy = true`" + ` to remove the user from the organization when the resource is destroyed; by default
+destroying the resource only stops managing the user.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "User identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "ID of the user to manage. Exactly one of `user_id` or `email` must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the user to manage (matched case-insensitively). " +
+					"Exactly one of `user_id` or `email` must be set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !strings.EqualFold(req.StateValue.ValueString(), req.PlanValue.ValueString())
+						},
+						"Changing the email to a different address manages a different user and requires replacement.",
+						"Changing the email to a different address manages a different user and requires replacement.",
+					),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Organization role of the user: `admin` or `member`. When unset, the current role is read but not managed.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "member"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remove_on_destroy": schema.BoolAttribute{
+				Description: "Remove the user from the organization when this resource is destroyed. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"first_name": schema.StringAttribute{
+				Description: "First name of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				Description: "Last name of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the user account.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the user was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the user was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *userResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("User identifier."),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create adopts the existing user and applies the configured role.
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.lookupUser(ctx, plan.UserID.ValueString(), plan.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adopting Portkey User",
+			"Could not find the Portkey user. Users must accept a portkey_user_invite before they can be managed: "+err.Error(),
+		)
+		return
+	}
+
+	user = r.applyRole(ctx, user, plan.Role, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapUserToState(user, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An import by email leaves the ID unset until the user is looked up.
+	user, err := r.lookupUser(ctx, state.ID.ValueString(), state.Email.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Portkey User",
+			"Could not read Portkey user "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if state.RemoveOnDestroy.IsNull() {
+		state.RemoveOnDestroy = types.BoolValue(false)
+	}
+	mapUserToState(user, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Update applies a changed role. remove_on_destroy only affects Delete.
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Portkey User",
+			"Could not read Portkey user "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	user = r.applyRole(ctx, user, plan.Role, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapUserToState(user, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Delete removes the user from the organization when remove_on_destroy is
+// set; otherwise it only removes the resource from Terraform state.
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RemoveOnDestroy.ValueBool() {
+		return
+	}
+
+	err := r.client.DeleteUser(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Portkey User",
+			"Could not remove user "+state.ID.ValueString()+" from the organization: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state.
+// Import format: "user_id" or "email"
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if strings.Contains(req.ID, "@") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
+		return
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// lookupUser fetches a user by ID, or by email when no ID is known.
+func (r *userResource) lookupUser(ctx context.Context, id, email string) (*client.User, error) {
+	if id != "" {
+		return r.client.GetUser(ctx, id)
+	}
+
+	return r.client.GetUserByEmail(ctx, email)
+}
+
+// applyRole sets the user's organization role when role is configured and
+// differs from the current one. The API rejects updates that do not change
+// the role, so unchanged roles are never sent.
+func (r *userResource) applyRole(ctx context.Context, user *client.User, role types.String, diags *diag.Diagnostics) *client.User {
+	if role.IsNull() || role.IsUnknown() || !userRoleNeedsUpdate(user.Role, role.ValueString()) {
+		return user
+	}
+
+	if _, err := r.client.UpdateUser(ctx, user.ID, client.UpdateUserRequest{Role: role.ValueString()}); err != nil {
+		diags.AddError(
+			"Error Updating Portkey User",
+			fmt.Sprintf("Could not set role of user %s to %s: %s", user.ID, role.ValueString(), err.Error()),
+		)
+		return user
+	}
+
+	updated, err := r.client.GetUser(ctx, user.ID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Portkey User",
+			"Could not read Portkey user "+user.ID+" after update: "+err.Error(),
+		)
+		return user
+	}
+	return updated
+}
+
+// userRoleNeedsUpdate reports whether current must be changed to reach desired.
+func userRoleNeedsUpdate(current, desired string) bool {
+	return desired != "" && !strings.EqualFold(current, desired)
+}
+
+// mapUserToState copies the API user into the model. A configured email is
+// kept as written when it differs only in case from the stored one.
+func mapUserToState(user *client.User, state *userResourceModel) {
+	state.ID = types.StringValue(user.ID)
+	state.UserID = types.StringValue(user.ID)
+	if state.Email.IsNull() || state.Email.IsUnknown() || !strings.EqualFold(state.Email.ValueString(), user.Email) {
+		state.Email = types.StringValue(user.Email)
+	}
+	state.Role = types.StringValue(user.Role)
+	state.FirstName = types.StringValue(user.FirstName)
+	state.LastName = types.StringValue(user.LastName)
+	state.Status = types.StringValue(user.Status)
+
+	if !user.CreatedAt.IsZero() {
+		state.CreatedAt = types.StringValue(user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	} else {
+		state.CreatedAt = types.StringNull()
+	}
+	if !user.UpdatedAt.IsZero() {
+		state.UpdatedAt = types.StringValue(user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	} else {
+		state.UpdatedAt = types.StringNull()
+	}
+}
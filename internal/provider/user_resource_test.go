@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUserRoleNeedsUpdate(t *testing.T) {
+	tests := []struct {
+		current, desired string
+		want             bool
+	}{
+		{"member", "admin", true},
+		{"admin", "admin", false},
+		{"Admin", "admin", false},
+		{"admin", "", false},
+	}
+	for _, tt := range tests {
+		if got := userRoleNeedsUpdate(tt.current, tt.desired); got != tt.want {
+			t.Errorf("userRoleNeedsUpdate(%q, %q) = %v, want %v", tt.current, tt.desired, got, tt.want)
+		}
+	}
+}
+
+// TestAccUserResource_adopt adopts the first user from the organization's user
+// list by email without managing their role. remove_on_destroy stays false so
+// the user is left in the organization when the test tears down.
+func TestAccUserResource_adopt(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("portkey_user.test", "id", "data.portkey_users.all", "users.0.id"),
+					resource.TestCheckResourceAttrSet("portkey_user.test", "role"),
+					resource.TestCheckResourceAttr("portkey_user.test", "remove_on_destroy", "false"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:            "portkey_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+		},
+	})
+}
+
+func testAccUserResourceConfig() string {
+	return `
+provider "portkey" {}
+
+# Get existing users from the organization
+data "portkey_users" "all" {}
+
+resource "portkey_user" "test" {
+  email = data.portkey_users.all.users[0].email
+}
+`
+}
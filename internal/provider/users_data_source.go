@@ -45,11 +45,13 @@ type usersDataSource struct {
 // source auto-paginates regardless of PageSize so callers always receive every
 // matching user. Total is the API-reported total count after filters.
 type usersDataSourceModel struct {
-	PageSize types.Int64  `tfsdk:"page_size"`
-	Role     types.String `tfsdk:"role"`
-	Email    types.String `tfsdk:"email"`
-	Total    types.Int64  `tfsdk:"total"`
-	Users    []userModel  `tfsdk:"users"`
+	PageSize types.Int64       `tfsdk:"page_size"`
+	Role     types.String      `tfsdk:"role"`
+	Email    types.String      `tfsdk:"email"`
+	Total    types.Int64       `tfsdk:"total"`
+	Users    []userModel       `tfsdk:"users"`
+	Filter   []listFilterModel `tfsdk:"filter"`
+	IDs      types.List        `tfsdk:"ids"`
 }
 
 // userModel maps user data
@@ -129,6 +131,10 @@ func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"ids": listIDsAttribute("users"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("users"),
 		},
 	}
 }
@@ -221,6 +227,12 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		// Last page reached when the API returns fewer than the requested
 		// page size (or zero rows on the very first request).
 		if len(apiResp.Data) < pageSize {
+			state.Users = applyListFilters(state.Users, state.Filter, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			state.IDs = listItemStrings(state.Users, "id")
+
 			diags = resp.State.Set(ctx, &state)
 			resp.Diagnostics.Append(diags...)
 			return
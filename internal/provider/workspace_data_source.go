@@ -6,8 +6,11 @@ import (
 
 	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -47,15 +50,21 @@ func (d *workspaceDataSource) Metadata(_ context.Context, req datasource.Metadat
 // Schema defines the schema for the data source.
 func (d *workspaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a specific Portkey workspace by ID.",
+		Description: "Fetches a specific Portkey workspace by ID or name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Workspace identifier.",
-				Required:    true,
+				Description: "Workspace identifier. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the workspace.",
-				Computed:    true,
+				Description: "Name of the workspace. Set it instead of id to look the workspace up by name; " +
+					"exactly one workspace must have this name.",
+				Optional: true,
+				Computed: true,
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the workspace.",
@@ -70,11 +79,11 @@ func (d *workspaceDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 							Description: "Type of usage limit.",
 							Computed:    true,
 						},
-						"credit_limit": schema.Int64Attribute{
+						"credit_limit": schema.Float64Attribute{
 							Description: "The credit limit value.",
 							Computed:    true,
 						},
-						"alert_threshold": schema.Int64Attribute{
+						"alert_threshold": schema.Float64Attribute{
 							Description: "Alert threshold in dollars.",
 							Computed:    true,
 						},
@@ -151,8 +160,33 @@ func (d *workspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	workspaceID := state.ID.ValueString()
+	if !state.Name.IsNull() {
+		workspaces, err := d.client.ListWorkspaces(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Portkey Workspaces",
+				err.Error(),
+			)
+			return
+		}
+
+		name := state.Name.ValueString()
+		match, err := findOneByName(workspaces, "workspace", name, func(w client.Workspace) bool {
+			return w.Name == name || stripIconPrefix(w.Name, w.Icon) == name
+		}, func(w client.Workspace) string { return w.ID })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Find Portkey Workspace",
+				err.Error(),
+			)
+			return
+		}
+		workspaceID = match.ID
+	}
+
 	// Get workspace from Portkey API
-	workspace, err := d.client.GetWorkspace(ctx, state.ID.ValueString())
+	workspace, err := d.client.GetWorkspace(ctx, workspaceID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Portkey Workspace",
@@ -163,7 +197,10 @@ func (d *workspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	// Map response to state
 	state.ID = types.StringValue(workspace.ID)
-	state.Name = types.StringValue(workspace.Name)
+	// Keep a configured name as-is; the API may prefix it with the icon.
+	if state.Name.IsNull() {
+		state.Name = types.StringValue(workspace.Name)
+	}
 	state.Description = types.StringValue(workspace.Description)
 
 	// Handle usage_limits
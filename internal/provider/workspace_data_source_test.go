@@ -30,6 +30,24 @@ func TestAccWorkspaceDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccWorkspaceDataSource_byName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-ds-name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceDataSourceConfigByName(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.portkey_workspace.test", "id", "portkey_workspace.test", "id"),
+					resource.TestCheckResourceAttr("data.portkey_workspace.test", "name", rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccWorkspaceDataSource_withUsageLimits(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-ds-ul")
 
@@ -90,6 +108,21 @@ data "portkey_workspace" "test" {
 `, name, description)
 }
 
+func testAccWorkspaceDataSourceConfigByName(name string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_workspace" "test" {
+  name = %[1]q
+}
+
+data "portkey_workspace" "test" {
+  name       = %[1]q
+  depends_on = [portkey_workspace.test]
+}
+`, name)
+}
+
 func testAccWorkspaceDataSourceConfigWithUsageLimits(name string) string {
 	return fmt.Sprintf(`
 provider "portkey" {}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// NewWorkspaceListResource lists workspaces for `terraform query`.
+func NewWorkspaceListResource() list.ListResource {
+	return newListResource(listResourceDefinition[client.Workspace]{
+		typeName: "workspace",
+		kind:     "workspaces",
+		title:    "Workspaces",
+		list: func(ctx context.Context, c *client.Client, _ string) ([]client.Workspace, error) {
+			return c.ListWorkspaces(ctx)
+		},
+		fields: map[string]listResourceField[client.Workspace]{
+			"name": {"name", func(w client.Workspace) string { return stripIconPrefix(w.Name, w.Icon) }},
+			"slug": {"slug", func(w client.Workspace) string { return w.Slug }},
+		},
+		identity: func(w client.Workspace, _ string) map[string]string {
+			return map[string]string{"id": w.ID}
+		},
+		displayName: func(w client.Workspace) string { return stripIconPrefix(w.Name, w.Icon) },
+		resource:    NewWorkspaceResource,
+	})
+}
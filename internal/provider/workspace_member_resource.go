@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +20,8 @@ var (
 	_ resource.Resource                = &workspaceMemberResource{}
 	_ resource.ResourceWithConfigure   = &workspaceMemberResource{}
 	_ resource.ResourceWithImportState = &workspaceMemberResource{}
+	_ resource.ResourceWithIdentity    = &workspaceMemberResource{}
+	_ resource.ResourceWithModifyPlan  = &workspaceMemberResource{}
 )
 
 // NewWorkspaceMemberResource is a helper function to simplify the provider implementation.
@@ -33,11 +36,12 @@ type workspaceMemberResource struct {
 
 // workspaceMemberResourceModel maps the resource schema data.
 type workspaceMemberResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	UserID      types.String `tfsdk:"user_id"`
-	Role        types.String `tfsdk:"role"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	ID            types.String `tfsdk:"id"`
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug types.String `tfsdk:"workspace_slug"`
+	UserID        types.String `tfsdk:"user_id"`
+	Role          types.String `tfsdk:"role"`
+	CreatedAt     types.String `tfsdk:"created_at"`
 }
 
 // Metadata returns the resource type name.
@@ -60,10 +64,8 @@ func (r *workspaceMemberResource) Schema(_ context.Context, _ resource.SchemaReq
 			"workspace_id": schema.StringAttribute{
 				Description: "ID of the workspace.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
+			"workspace_slug": workspaceSlugAttribute(),
 			"user_id": schema.StringAttribute{
 				Description: "ID of the user to add to the workspace.",
 				Required:    true,
@@ -86,6 +88,16 @@ func (r *workspaceMemberResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *workspaceMemberResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityAttribute("Workspace identifier (UUID)."),
+			"user_id":      identityAttribute("User identifier."),
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *workspaceMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -106,6 +118,12 @@ func (r *workspaceMemberResource) Configure(_ context.Context, req resource.Conf
 	r.client = client
 }
 
+// ModifyPlan replaces the membership only when workspace_id moves to another
+// workspace, not when it switches between the ID and the slug of the same one.
+func (r *workspaceMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *workspaceMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -141,6 +159,8 @@ func (r *workspaceMemberResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -176,6 +196,8 @@ func (r *workspaceMemberResource) Read(ctx context.Context, req resource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -213,6 +235,8 @@ func (r *workspaceMemberResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -238,8 +262,10 @@ func (r *workspaceMemberResource) Delete(ctx context.Context, req resource.Delet
 
 // ImportState imports the resource state.
 func (r *workspaceMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics, "workspace_id", "user_id")
+
 	// Import ID should be in format: workspace_id/user_id
-	parts := strings.Split(req.ID, "/")
+	parts := strings.Split(id, "/")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
@@ -248,6 +274,11 @@ func (r *workspaceMemberResource) ImportState(ctx context.Context, req resource.
 		return
 	}
 
+	checkImportedWorkspace(ctx, r.client, parts[0], &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workspaceMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceMembersDataSource{}
+)
+
+// NewWorkspaceMembersDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceMembersDataSource() datasource.DataSource {
+	return &workspaceMembersDataSource{}
+}
+
+// workspaceMembersDataSource is the data source implementation.
+type workspaceMembersDataSource struct {
+	client *client.Client
+}
+
+// workspaceMembersDataSourceModel maps the data source schema data.
+type workspaceMembersDataSourceModel struct {
+	WorkspaceID   types.String               `tfsdk:"workspace_id"`
+	Role          types.String               `tfsdk:"role"`
+	EmailContains types.String               `tfsdk:"email_contains"`
+	Members       []workspaceMemberDataModel `tfsdk:"members"`
+	Filter        []listFilterModel          `tfsdk:"filter"`
+}
+
+// workspaceMemberDataModel maps a single workspace member joined with its user.
+type workspaceMemberDataModel struct {
+	UserID    types.String `tfsdk:"user_id"`
+	Role      types.String `tfsdk:"role"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_members"
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the members of a Portkey workspace with their email and name. " +
+			"Pages through the API until every member is returned; filters are applied to the full list.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Required:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Only return members with this workspace role: `admin`, `manager` or `member`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "manager", "member"),
+				},
+			},
+			"email_contains": schema.StringAttribute{
+				Description: "Only return members whose email contains this string (case-insensitive).",
+				Optional:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "Members matching the filters, ordered as returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "User identifier.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the user in the workspace.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address of the user.",
+							Computed:    true,
+						},
+						"first_name": schema.StringAttribute{
+							Description: "First name of the user, if known.",
+							Computed:    true,
+						},
+						"last_name": schema.StringAttribute{
+							Description: "Last name of the user, if known.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the user was added to the workspace.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("members"),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state workspaceMembersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.ListWorkspaceMembers(ctx, state.WorkspaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Portkey Workspace Members",
+			err.Error(),
+		)
+		return
+	}
+
+	// The members endpoint does not always include user details. Fill them in
+	// from the organization's user list, fetched only when needed.
+	if workspaceMembersNeedUserDetails(members) {
+		users, err := d.client.ListUsers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Portkey Users",
+				err.Error(),
+			)
+			return
+		}
+		joinWorkspaceMemberUsers(members, users)
+	}
+
+	members = filterWorkspaceMembers(members, state.Role.ValueString(), state.EmailContains.ValueString())
+
+	state.Members = make([]workspaceMemberDataModel, 0, len(members))
+	for _, m := range members {
+		item := workspaceMemberDataModel{
+			UserID:    types.StringValue(workspaceMemberUserID(m)),
+			Role:      types.StringValue(m.Role),
+			Email:     stringOrNull(m.Email),
+			FirstName: stringOrNull(m.FirstName),
+			LastName:  stringOrNull(m.LastName),
+		}
+
+		if !m.CreatedAt.IsZero() {
+			item.CreatedAt = types.StringValue(m.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+		} else {
+			item.CreatedAt = types.StringNull()
+		}
+
+		state.Members = append(state.Members, item)
+	}
+
+	state.Members = applyListFilters(state.Members, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// workspaceMemberUserID returns the user ID of a member, falling back to the
+// member ID for responses that only carry one identifier.
+func workspaceMemberUserID(m client.WorkspaceMember) string {
+	if m.UserID != "" {
+		return m.UserID
+	}
+	return m.ID
+}
+
+// workspaceMembersNeedUserDetails reports whether any member is missing an email.
+func workspaceMembersNeedUserDetails(members []client.WorkspaceMember) bool {
+	for _, m := range members {
+		if m.Email == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// joinWorkspaceMemberUsers fills in missing email and name fields on members
+// from the matching organization users.
+func joinWorkspaceMemberUsers(members []client.WorkspaceMember, users []client.User) {
+	byID := make(map[string]client.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	for i := range members {
+		u, ok := byID[workspaceMemberUserID(members[i])]
+		if !ok {
+			continue
+		}
+		if members[i].Email == "" {
+			members[i].Email = u.Email
+		}
+		if members[i].FirstName == "" {
+			members[i].FirstName = u.FirstName
+		}
+		if members[i].LastName == "" {
+			members[i].LastName = u.LastName
+		}
+	}
+}
+
+// filterWorkspaceMembers keeps the members matching role (exact) and
+// emailContains (case-insensitive substring). Empty filters match everything.
+func filterWorkspaceMembers(members []client.WorkspaceMember, role, emailContains string) []client.WorkspaceMember {
+	needle := strings.ToLower(emailContains)
+	result := make([]client.WorkspaceMember, 0, len(members))
+	for _, m := range members {
+		if role != "" && m.Role != role {
+			continue
+		}
+		if needle != "" && !strings.Contains(strings.ToLower(m.Email), needle) {
+			continue
+		}
+		result = append(result, m)
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+func TestJoinAndFilterWorkspaceMembers(t *testing.T) {
+	members := []client.WorkspaceMember{
+		{ID: "user-1", UserID: "user-1", Role: "admin"},
+		{ID: "user-2", UserID: "user-2", Role: "member", Email: "bob@example.com"},
+		{ID: "user-3", Role: "member"},
+	}
+	users := []client.User{
+		{ID: "user-1", Email: "Alice@Example.com", FirstName: "Alice", LastName: "Smith"},
+		{ID: "user-2", Email: "ignored@example.com", FirstName: "Bob"},
+		{ID: "user-3", Email: "carol@other.org"},
+	}
+
+	if !workspaceMembersNeedUserDetails(members) {
+		t.Fatal("expected members without email to need user details")
+	}
+	joinWorkspaceMemberUsers(members, users)
+
+	if members[0].Email != "Alice@Example.com" || members[0].FirstName != "Alice" || members[0].LastName != "Smith" {
+		t.Errorf("user details not joined: %+v", members[0])
+	}
+	if members[1].Email != "bob@example.com" || members[1].FirstName != "Bob" {
+		t.Errorf("existing email should be kept and missing name filled: %+v", members[1])
+	}
+	if members[2].Email != "carol@other.org" {
+		t.Errorf("member keyed by ID only was not joined: %+v", members[2])
+	}
+
+	got := filterWorkspaceMembers(members, "member", "")
+	if len(got) != 2 {
+		t.Errorf("role filter: expected 2 members, got %d", len(got))
+	}
+	got = filterWorkspaceMembers(members, "", "@example.COM")
+	if len(got) != 2 || got[0].UserID != "user-1" || got[1].UserID != "user-2" {
+		t.Errorf("email filter: unexpected result %+v", got)
+	}
+}
+
+func TestAccWorkspaceMembersDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-wsmembers-ds")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceMembersDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.portkey_workspace_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.portkey_workspace_members.test", "members.0.user_id",
+						"data.portkey_users.all", "users.0.id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.portkey_workspace_members.test", "members.0.email",
+						"data.portkey_users.all", "users.0.email",
+					),
+					resource.TestCheckResourceAttr("data.portkey_workspace_members.test", "members.0.role", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceMembersDataSourceConfig(workspaceName string) string {
+	return testAccWorkspaceMembersResourceConfig(workspaceName) + `
+data "portkey_workspace_members" "test" {
+  workspace_id = portkey_workspace_members.test.workspace_id
+  role         = "admin"
+}
+`
+}
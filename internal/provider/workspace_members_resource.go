@@ -0,0 +1,494 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workspaceMembersResource{}
+	_ resource.ResourceWithConfigure   = &workspaceMembersResource{}
+	_ resource.ResourceWithImportState = &workspaceMembersResource{}
+	_ resource.ResourceWithMoveState   = &workspaceMembersResource{}
+	_ resource.ResourceWithIdentity    = &workspaceMembersResource{}
+	_ resource.ResourceWithModifyPlan  = &workspaceMembersResource{}
+)
+
+// NewWorkspaceMembersResource is a helper function to simplify the provider implementation.
+func NewWorkspaceMembersResource() resource.Resource {
+	return &workspaceMembersResource{}
+}
+
+// workspaceMembersResource is the resource implementation.
+type workspaceMembersResource struct {
+	client *client.Client
+}
+
+// workspaceMembersResourceModel maps the resource schema data.
+type workspaceMembersResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	WorkspaceSlug types.String `tfsdk:"workspace_slug"`
+	Members       types.Map    `tfsdk:"members"`
+
+	RemoveMembersOnDestroy types.Bool `tfsdk:"remove_members_on_destroy"`
+}
+
+// workspaceMembersDiff is the set of API calls needed to move a workspace
+// from its current member set to the desired one.
+type workspaceMembersDiff struct {
+	Add    []client.AddWorkspaceMemberRequest
+	Update []client.AddWorkspaceMemberRequest
+	Remove []string
+}
+
+// Metadata returns the resource type name.
+func (r *workspaceMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_members"
+}
+
+// Schema defines the schema for the resource.
+func (r *workspaceMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Authoritatively manages the full member set of a Portkey workspace.
+
+Members added outside Terraform (through the UI or SCIM) show up as drift and are removed on the next apply.
+Workspace admins and owners are only managed when listed in ` + "`members`" + `; others are left in place.
+Do not combine this resource with ` + "`portkey_workspace_member`" + ` for the same workspace.
+
+Destroying the resource leaves the members in the workspace unless ` + "`remove_members_on_destroy`" + ` is true.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this resource (the workspace ID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace.",
+				Required:    true,
+			},
+			"workspace_slug": workspaceSlugAttribute(),
+			"members": schema.MapAttribute{
+				Description: "Map of user ID to workspace role ('admin', 'manager' or 'member'). Any member not listed here is removed from the workspace, apart from admins and owners.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(
+						stringvalidator.OneOf("admin", "manager", "member"),
+					),
+				},
+			},
+			"remove_members_on_destroy": schema.BoolAttribute{
+				Description: "Whether destroying this resource removes the members listed in `members` from the workspace. " +
+					"Defaults to false, which leaves every member in place. Workspace admins and owners are never removed.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *workspaceMembersResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityAttribute("Workspace identifier (UUID)."),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workspaceMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan warns about members that exist in the workspace but are not in
+// configuration, since applying the plan removes them. On create, when the
+// resource adopts an existing workspace, the current members are listed.
+func (r *workspaceMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyWorkspaceReferencePlan(ctx, req, resp, r.client)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to report on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan workspaceMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Members.IsUnknown() {
+		return
+	}
+
+	desired := workspaceMembersFromMap(ctx, plan.Members, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current map[string]string
+	if req.State.Raw.IsNull() {
+		// A workspace created in the same apply has no members to report.
+		if r.client == nil || plan.WorkspaceID.IsUnknown() || plan.WorkspaceID.IsNull() {
+			return
+		}
+		members, err := r.client.ListWorkspaceMembers(ctx, plan.WorkspaceID.ValueString())
+		if err != nil {
+			if client.IsNotFound(err) {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading Portkey Workspace Members",
+				"Could not list members of workspace "+plan.WorkspaceID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		current = workspaceMembersByUserID(members)
+	} else {
+		var state workspaceMembersResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		current = workspaceMembersFromMap(ctx, state.Members, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diff := diffWorkspaceMembers(current, desired)
+	if len(diff.Remove) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("members"),
+			"Unmanaged Workspace Members Will Be Removed",
+			fmt.Sprintf("The following users are members of workspace %s but are not in configuration, and will be removed: %s",
+				plan.WorkspaceID.ValueString(), strings.Join(diff.Remove, ", ")),
+		)
+	}
+}
+
+// Create reconciles the workspace members with the plan and sets the initial Terraform state.
+func (r *workspaceMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workspaceMembersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.WorkspaceID
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the full member set of the workspace.
+func (r *workspaceMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workspaceMembersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.ListWorkspaceMembers(ctx, state.WorkspaceID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Portkey Workspace Members",
+			"Could not list members of workspace "+state.WorkspaceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Admins and owners are only tracked once they are in state, i.e. listed
+	// in configuration, since unlisted ones are never removed.
+	current := workspaceMembersTracked(workspaceMembersByUserID(members), workspaceMembersFromMap(ctx, state.Members, &resp.Diagnostics))
+	state.ID = state.WorkspaceID
+	if state.RemoveMembersOnDestroy.IsNull() {
+		state.RemoveMembersOnDestroy = types.BoolValue(false)
+	}
+	state.Members, diags = types.MapValueFrom(ctx, types.StringType, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Update reconciles the workspace members with the plan.
+func (r *workspaceMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workspaceMembersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	setWorkspaceSlug(ctx, r.client, &resp.State, &resp.Diagnostics)
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+// Delete leaves the workspace members in place unless
+// remove_members_on_destroy is true, in which case it removes the members
+// recorded in state, apart from admins and owners.
+func (r *workspaceMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workspaceMembersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RemoveMembersOnDestroy.ValueBool() {
+		return
+	}
+
+	managed := workspaceMembersFromMap(ctx, state.Members, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check current roles, so a member promoted outside Terraform is kept.
+	members, err := r.client.ListWorkspaceMembers(ctx, state.WorkspaceID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Portkey Workspace Members",
+			"Could not list members of workspace "+state.WorkspaceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, userID := range workspaceMembersRemovedOnDestroy(workspaceMembersByUserID(members), managed) {
+		err := r.client.RemoveWorkspaceMember(ctx, state.WorkspaceID.ValueString(), userID)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Removing Portkey Workspace Member",
+				"Could not remove user "+userID+" from workspace "+state.WorkspaceID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// ImportState imports the resource state.
+// Import format: "workspace_id"
+func (r *workspaceMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics, "workspace_id")
+
+	checkImportedWorkspace(ctx, r.client, id, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), id)...)
+}
+
+// MoveState moves a portkey_workspace_member into the authoritative member
+// set of its workspace. Read then records every current member, so members
+// missing from configuration show up in the next plan.
+func (r *workspaceMembersResource) MoveState(_ context.Context) []resource.StateMover {
+	return importStateMovers(r,
+		attributeSource("portkey_workspace_member", "%s", []string{"workspace_id"}),
+	)
+}
+
+// reconcile lists the current members of the workspace and applies the adds,
+// role changes and removals needed to match plan.Members. Adds are sent as a
+// single batched request. Admins and owners missing from plan.Members are left
+// in place.
+func (r *workspaceMembersResource) reconcile(ctx context.Context, plan workspaceMembersResourceModel, diags *diag.Diagnostics) {
+	workspaceID := plan.WorkspaceID.ValueString()
+
+	desired := workspaceMembersFromMap(ctx, plan.Members, diags)
+	if diags.HasError() {
+		return
+	}
+
+	members, err := r.client.ListWorkspaceMembers(ctx, workspaceID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Portkey Workspace Members",
+			"Could not list members of workspace "+workspaceID+": "+err.Error(),
+		)
+		return
+	}
+
+	diff := diffWorkspaceMembers(workspaceMembersByUserID(members), desired)
+
+	if err := r.client.AddWorkspaceMembers(ctx, workspaceID, diff.Add); err != nil {
+		diags.AddError(
+			"Error Adding Portkey Workspace Members",
+			"Could not add members to workspace "+workspaceID+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, u := range diff.Update {
+		_, err := r.client.UpdateWorkspaceMember(ctx, workspaceID, u.UserID, client.UpdateWorkspaceMemberRequest{Role: u.Role})
+		if err != nil {
+			diags.AddError(
+				"Error Updating Portkey Workspace Member",
+				"Could not change the role of user "+u.UserID+" in workspace "+workspaceID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	for _, userID := range diff.Remove {
+		err := r.client.RemoveWorkspaceMember(ctx, workspaceID, userID)
+		if err != nil && !client.IsNotFound(err) {
+			diags.AddError(
+				"Error Removing Portkey Workspace Member",
+				"Could not remove user "+userID+" from workspace "+workspaceID+": "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// diffWorkspaceMembers computes the changes needed to turn current into
+// desired. Both maps are keyed by user ID with the role as value; results are
+// sorted by user ID so plans and API calls are deterministic. Current admins
+// and owners that desired does not list are not removed.
+func diffWorkspaceMembers(current, desired map[string]string) workspaceMembersDiff {
+	var diff workspaceMembersDiff
+
+	for _, userID := range sortedMemberIDs(desired) {
+		role := desired[userID]
+		currentRole, ok := current[userID]
+		switch {
+		case !ok:
+			diff.Add = append(diff.Add, client.AddWorkspaceMemberRequest{UserID: userID, Role: role})
+		case currentRole != role:
+			diff.Update = append(diff.Update, client.AddWorkspaceMemberRequest{UserID: userID, Role: role})
+		}
+	}
+
+	for _, userID := range sortedMemberIDs(current) {
+		if _, ok := desired[userID]; !ok && !isProtectedWorkspaceRole(current[userID]) {
+			diff.Remove = append(diff.Remove, userID)
+		}
+	}
+
+	return diff
+}
+
+// workspaceMembersRemovedOnDestroy returns the user IDs, in ascending order,
+// of the managed members that are still in the workspace with a role other
+// than admin or owner. current holds the workspace's members and their roles.
+func workspaceMembersRemovedOnDestroy(current, managed map[string]string) []string {
+	var remove []string
+	for _, userID := range sortedMemberIDs(managed) {
+		role, ok := current[userID]
+		if ok && !isProtectedWorkspaceRole(role) {
+			remove = append(remove, userID)
+		}
+	}
+	return remove
+}
+
+// isProtectedWorkspaceRole reports whether members with role are only removed
+// by name: workspace admins and owners.
+func isProtectedWorkspaceRole(role string) bool {
+	return role == "admin" || role == "owner"
+}
+
+// workspaceMembersTracked returns the members of current that belong in
+// state: everyone except admins and owners missing from prior.
+func workspaceMembersTracked(current, prior map[string]string) map[string]string {
+	tracked := make(map[string]string, len(current))
+	for userID, role := range current {
+		if _, ok := prior[userID]; ok || !isProtectedWorkspaceRole(role) {
+			tracked[userID] = role
+		}
+	}
+	return tracked
+}
+
+// workspaceMembersByUserID indexes API members by user ID.
+func workspaceMembersByUserID(members []client.WorkspaceMember) map[string]string {
+	result := make(map[string]string, len(members))
+	for _, m := range members {
+		result[workspaceMemberUserID(m)] = m.Role
+	}
+	return result
+}
+
+// workspaceMembersFromMap converts the members attribute into a Go map.
+func workspaceMembersFromMap(ctx context.Context, value types.Map, diags *diag.Diagnostics) map[string]string {
+	result := map[string]string{}
+	if value.IsNull() || value.IsUnknown() {
+		return result
+	}
+	diags.Append(value.ElementsAs(ctx, &result, false)...)
+	return result
+}
+
+// sortedMemberIDs returns the user IDs of m in ascending order.
+func sortedMemberIDs(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+func TestDiffWorkspaceMembers(t *testing.T) {
+	current := map[string]string{
+		"user-a": "admin",
+		"user-b": "member",
+		"user-c": "member",
+		"user-f": "owner", // unlisted owners are kept
+	}
+	desired := map[string]string{
+		"user-a": "admin",
+		"user-b": "manager",
+		"user-e": "member",
+		"user-d": "admin",
+	}
+
+	got := diffWorkspaceMembers(current, desired)
+
+	wantAdd := []client.AddWorkspaceMemberRequest{
+		{UserID: "user-d", Role: "admin"},
+		{UserID: "user-e", Role: "member"},
+	}
+	wantUpdate := []client.AddWorkspaceMemberRequest{
+		{UserID: "user-b", Role: "manager"},
+	}
+	wantRemove := []string{"user-c"}
+
+	if !reflect.DeepEqual(got.Add, wantAdd) {
+		t.Errorf("Add = %+v, want %+v", got.Add, wantAdd)
+	}
+	if !reflect.DeepEqual(got.Update, wantUpdate) {
+		t.Errorf("Update = %+v, want %+v", got.Update, wantUpdate)
+	}
+	if !reflect.DeepEqual(got.Remove, wantRemove) {
+		t.Errorf("Remove = %v, want %v", got.Remove, wantRemove)
+	}
+}
+
+func TestDiffWorkspaceMembers_NoChanges(t *testing.T) {
+	members := map[string]string{"user-a": "admin"}
+	got := diffWorkspaceMembers(members, members)
+	if len(got.Add) != 0 || len(got.Update) != 0 || len(got.Remove) != 0 {
+		t.Errorf("expected empty diff, got %+v", got)
+	}
+}
+
+func TestWorkspaceMembersTracked(t *testing.T) {
+	current := map[string]string{
+		"user-a": "admin",
+		"user-b": "member",
+		"user-c": "owner",
+	}
+	prior := map[string]string{"user-a": "admin"}
+
+	got := workspaceMembersTracked(current, prior)
+	want := map[string]string{"user-a": "admin", "user-b": "member"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("workspaceMembersTracked() = %v, want %v", got, want)
+	}
+}
+
+func TestWorkspaceMembersRemovedOnDestroy(t *testing.T) {
+	current := map[string]string{
+		"user-a": "admin",
+		"user-b": "member",
+		"user-c": "manager",
+		"user-d": "owner",
+		"user-f": "member",
+	}
+	managed := map[string]string{
+		"user-a": "admin",
+		"user-b": "member",
+		"user-c": "manager",
+		"user-d": "member", // promoted outside Terraform
+		"user-e": "member", // already gone
+	}
+
+	got := workspaceMembersRemovedOnDestroy(current, managed)
+	want := []string{"user-b", "user-c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("workspaceMembersRemovedOnDestroy() = %v, want %v", got, want)
+	}
+}
+
+// TestWorkspaceMembersResource_adoptKeepsUnlistedAdmin adopts a workspace
+// whose admin is not in configuration: the plan warns about the unlisted
+// member that will be removed, and neither the plan nor the apply touches the
+// admin.
+func TestWorkspaceMembersResource_adoptKeepsUnlistedAdmin(t *testing.T) {
+	ctx := context.Background()
+	var writes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes = append(writes, r.Method+" "+r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		if r.URL.Path != "/admin/workspaces/ws-1/users" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"total":3,"data":[{"user_id":"u-admin","role":"admin"},{"user_id":"u-old","role":"member"},{"user_id":"u-kept","role":"member"}]}`))
+	}))
+	defer srv.Close()
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	r := NewWorkspaceMembersResource().(*workspaceMembersResource)
+	r.client = c
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	vals := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		vals[name] = tftypes.NewValue(attrType, nil)
+	}
+	vals["id"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	vals["workspace_id"] = tftypes.NewValue(tftypes.String, "ws-1")
+	vals["members"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"u-kept": tftypes.NewValue(tftypes.String, "member"),
+	})
+	vals["remove_members_on_destroy"] = tftypes.NewValue(tftypes.Bool, false)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, vals)}
+	nullState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}
+
+	planResp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: nullState}, &planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan: unexpected errors: %v", planResp.Diagnostics)
+	}
+	if len(planResp.Diagnostics.Warnings()) != 1 {
+		t.Fatalf("ModifyPlan: expected one warning, got %v", planResp.Diagnostics)
+	}
+	if detail := planResp.Diagnostics.Warnings()[0].Detail(); !strings.HasSuffix(detail, "will be removed: u-old") {
+		t.Errorf("ModifyPlan warning = %q, want only u-old listed", detail)
+	}
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create: unexpected errors: %v", createResp.Diagnostics)
+	}
+	want := []string{"DELETE /admin/workspaces/ws-1/users/u-old"}
+	if !slices.Equal(writes, want) {
+		t.Errorf("writes = %v, want %v", writes, want)
+	}
+}
+
+// TestAccWorkspaceMembersResource_basic manages the member set of a new
+// workspace using the first user from the organization's user list.
+// Note: Org owners always get "admin" role in workspaces regardless of the requested role.
+func TestAccWorkspaceMembersResource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-wsmembers")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceMembersResourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("portkey_workspace_members.test", "id", "portkey_workspace.test", "id"),
+					resource.TestCheckResourceAttr("portkey_workspace_members.test", "members.%", "1"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "portkey_workspace_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWorkspaceMembersResourceConfig(workspaceName string) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+# Get existing users from the organization
+data "portkey_users" "all" {}
+
+resource "portkey_workspace" "test" {
+  name        = %[1]q
+  description = "Test workspace for authoritative member testing"
+}
+
+resource "portkey_workspace_members" "test" {
+  workspace_id = portkey_workspace.test.id
+  members = {
+    (data.portkey_users.all.users[0].id) = "admin"
+  }
+}
+`, workspaceName)
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// Workspace references
+//
+// Users may set workspace_id to either the UUID or the slug of a workspace,
+// while the API always returns UUIDs. Resources keep the value from the
+// configuration, and the helpers below use the client's cached workspace list
+// to tell when two references name the same workspace. Resolution is
+// best-effort: API keys that cannot list workspaces fall back to comparing
+// the references as strings.
+
+// workspaceSlugAttribute returns the computed workspace_slug attribute of
+// resources with a workspace_id.
+func workspaceSlugAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Slug of the workspace referenced by `workspace_id`, whichever form `workspace_id` is given in. " +
+			"Null when the API key cannot list workspaces.",
+		Computed: true,
+	}
+}
+
+// sameWorkspace reports whether the references a and b, each an ID or a
+// slug, name the same workspace.
+func sameWorkspace(ctx context.Context, c *client.Client, a, b string) bool {
+	if a == b {
+		return true
+	}
+	if c == nil {
+		return false
+	}
+	wa, err := c.ResolveWorkspace(ctx, a)
+	if err != nil {
+		return false
+	}
+	wb, err := c.ResolveWorkspace(ctx, b)
+	if err != nil {
+		return false
+	}
+	return wa.ID == wb.ID
+}
+
+// resolveWorkspaceSlug returns the slug of the workspace referenced by ref,
+// and false when it cannot be resolved.
+func resolveWorkspaceSlug(ctx context.Context, c *client.Client, ref string) (types.String, bool) {
+	if c == nil {
+		return types.StringNull(), false
+	}
+	ws, err := c.ResolveWorkspace(ctx, ref)
+	if err != nil {
+		return types.StringNull(), false
+	}
+	if ws.Slug == "" {
+		return types.StringNull(), true
+	}
+	return types.StringValue(ws.Slug), true
+}
+
+// modifyWorkspaceReferencePlan replaces the resource when workspace_id moves
+// to another workspace and plans workspace_slug. Switching workspace_id
+// between the ID and the slug of the same workspace is an in-place update, so
+// the workspace_id attribute must not carry RequiresReplace itself. Call it
+// in ModifyPlan before modifyDeletionProtectionPlan, which warns about
+// planned replacements.
+func modifyWorkspaceReferencePlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, c *client.Client) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("workspace_id"), &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior, priorSlug types.String
+	replace := false
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("workspace_id"), &prior)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("workspace_slug"), &priorSlug)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planned.Equal(prior) {
+			replace = planned.IsNull() || planned.IsUnknown() || prior.IsNull() ||
+				!sameWorkspace(ctx, c, planned.ValueString(), prior.ValueString())
+		}
+		if replace {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("workspace_id"))
+		}
+	}
+
+	slug := types.StringUnknown()
+	switch {
+	case planned.IsNull():
+		slug = types.StringNull()
+	case planned.IsUnknown():
+	default:
+		if resolved, ok := resolveWorkspaceSlug(ctx, c, planned.ValueString()); ok {
+			slug = resolved
+		} else if !req.State.Raw.IsNull() && !replace {
+			slug = priorSlug
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("workspace_slug"), slug)...)
+}
+
+// setWorkspaceSlug sets workspace_slug in state from workspace_id. When the
+// workspace cannot be resolved a known slug is kept and an unknown one
+// becomes null. Call it after State.Set in Create, Read and Update.
+func setWorkspaceSlug(ctx context.Context, c *client.Client, state *tfsdk.State, diags *diag.Diagnostics) {
+	if state.Raw.IsNull() {
+		return
+	}
+
+	var ref, slug types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("workspace_id"), &ref)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("workspace_slug"), &slug)...)
+	if diags.HasError() {
+		return
+	}
+
+	switch {
+	case ref.IsNull() || ref.IsUnknown():
+		slug = types.StringNull()
+	default:
+		if resolved, ok := resolveWorkspaceSlug(ctx, c, ref.ValueString()); ok {
+			slug = resolved
+		} else if slug.IsUnknown() {
+			slug = types.StringNull()
+		}
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("workspace_slug"), slug)...)
+}
+
+// checkImportedWorkspace adds an error when ref, the workspace part of an
+// import ID, is neither the ID nor the slug of a workspace. Both forms are
+// accepted; when workspaces cannot be listed ref is used as given.
+func checkImportedWorkspace(ctx context.Context, c *client.Client, ref string, diags *diag.Diagnostics) {
+	if c == nil {
+		return
+	}
+	if _, err := c.ResolveWorkspace(ctx, ref); errors.Is(err, client.ErrWorkspaceNotFound) {
+		diags.AddError(
+			"Unknown Workspace",
+			"No workspace has the ID or slug "+ref+". Import IDs accept either form.",
+		)
+	}
+}
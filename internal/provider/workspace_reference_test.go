@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/client"
+)
+
+// newWorkspaceTestClient returns a client whose workspace list holds
+// ws-uuid-1 (slug ws-prod) and ws-uuid-2 (slug ws-dev).
+func newWorkspaceTestClient(t *testing.T) *client.Client {
+	t.Helper()
+	workspaces := map[string]string{
+		"ws-uuid-1": `{"id":"ws-uuid-1","slug":"ws-prod","name":"Production"}`,
+		"ws-uuid-2": `{"id":"ws-uuid-2","slug":"ws-dev","name":"Development"}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id := strings.TrimPrefix(r.URL.Path, "/admin/workspaces/"); id != r.URL.Path {
+			if body, ok := workspaces[id]; ok {
+				_, _ = w.Write([]byte(body))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"total":2,"data":[` + workspaces["ws-uuid-1"] + `,` + workspaces["ws-uuid-2"] + `]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestSameWorkspace(t *testing.T) {
+	ctx := context.Background()
+	c := newWorkspaceTestClient(t)
+
+	for _, tc := range []struct {
+		a, b string
+		want bool
+	}{
+		{"ws-prod", "ws-prod", true},
+		{"ws-prod", "ws-uuid-1", true},
+		{"ws-uuid-1", "ws-prod", true},
+		{"ws-prod", "ws-dev", false},
+		{"ws-prod", "ws-missing", false},
+	} {
+		if got := sameWorkspace(ctx, c, tc.a, tc.b); got != tc.want {
+			t.Errorf("sameWorkspace(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+
+	if sameWorkspace(ctx, nil, "ws-prod", "ws-uuid-1") {
+		t.Error("expected references to differ without a client")
+	}
+}
+
+func TestCheckImportedWorkspace(t *testing.T) {
+	ctx := context.Background()
+	c := newWorkspaceTestClient(t)
+
+	for _, ref := range []string{"ws-prod", "ws-uuid-2"} {
+		var diags diag.Diagnostics
+		checkImportedWorkspace(ctx, c, ref, &diags)
+		if diags.HasError() {
+			t.Errorf("checkImportedWorkspace(%q): unexpected error: %v", ref, diags)
+		}
+	}
+
+	var diags diag.Diagnostics
+	checkImportedWorkspace(ctx, c, "ws-missing", &diags)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Unknown Workspace" {
+		t.Errorf("expected Unknown Workspace error, got %v", diags)
+	}
+}
+
+func TestModifyWorkspaceReferencePlan(t *testing.T) {
+	ctx := context.Background()
+	c := newWorkspaceTestClient(t)
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace_id":   schema.StringAttribute{Required: true},
+			"workspace_slug": workspaceSlugAttribute(),
+		},
+	}
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"workspace_id":   tftypes.String,
+		"workspace_slug": tftypes.String,
+	}}
+	object := func(id, slug interface{}) tftypes.Value {
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"workspace_id":   tftypes.NewValue(tftypes.String, id),
+			"workspace_slug": tftypes.NewValue(tftypes.String, slug),
+		})
+	}
+
+	for _, tc := range []struct {
+		name        string
+		state       tftypes.Value
+		planned     string
+		wantReplace bool
+		wantSlug    types.String
+	}{
+		{"create", tftypes.NewValue(objType, nil), "ws-uuid-2", false, types.StringValue("ws-dev")},
+		{"unchanged", object("ws-prod", "ws-prod"), "ws-prod", false, types.StringValue("ws-prod")},
+		{"slug to id", object("ws-prod", "ws-prod"), "ws-uuid-1", false, types.StringValue("ws-prod")},
+		{"other workspace", object("ws-prod", "ws-prod"), "ws-dev", true, types.StringValue("ws-dev")},
+		{"unknown workspace", object("ws-prod", "ws-prod"), "ws-missing", true, types.StringUnknown()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: s, Raw: object(tc.planned, tftypes.UnknownValue)}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: object(tc.planned, nil)},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: s, Raw: tc.state},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			modifyWorkspaceReferencePlan(ctx, req, resp, c)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if got := resp.RequiresReplace.Contains(path.Root("workspace_id")); got != tc.wantReplace {
+				t.Errorf("RequiresReplace = %v, want %v", got, tc.wantReplace)
+			}
+			var slug types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("workspace_slug"), &slug)...)
+			if !slug.Equal(tc.wantSlug) {
+				t.Errorf("workspace_slug = %s, want %s", slug, tc.wantSlug)
+			}
+		})
+	}
+}
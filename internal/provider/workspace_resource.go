@@ -10,8 +10,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,9 +22,13 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &workspaceResource{}
-	_ resource.ResourceWithConfigure   = &workspaceResource{}
-	_ resource.ResourceWithImportState = &workspaceResource{}
+	_ resource.Resource                 = &workspaceResource{}
+	_ resource.ResourceWithConfigure    = &workspaceResource{}
+	_ resource.ResourceWithImportState  = &workspaceResource{}
+	_ resource.ResourceWithMoveState    = &workspaceResource{}
+	_ resource.ResourceWithUpgradeState = &workspaceResource{}
+	_ resource.ResourceWithModifyPlan   = &workspaceResource{}
+	_ resource.ResourceWithIdentity     = &workspaceResource{}
 )
 
 // NewWorkspaceResource is a helper function to simplify the provider implementation.
@@ -46,8 +52,18 @@ type workspaceResourceModel struct {
 	Metadata    types.Map    `tfsdk:"metadata"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+
+	DeletionPolicy     types.String `tfsdk:"deletion_policy"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
+// Values of the workspace deletion_policy attribute.
+const (
+	workspaceDeletionCascade        = "cascade"
+	workspaceDeletionFailIfNotEmpty = "fail_if_not_empty"
+	workspaceDeletionAbandon        = "abandon"
+)
+
 // stripIconPrefix removes the icon emoji prefix from a workspace name.
 // The Portkey API prepends the icon to the name in GET responses (e.g.
 // icon="🚀", name="🚀 Production"). This function strips it so state stores
@@ -72,6 +88,7 @@ func (r *workspaceResource) Metadata(_ context.Context, req resource.MetadataReq
 func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Portkey workspace. Workspaces are sub-organizational units that enable granular project and team management.",
+		Version:     int64(len(workspaceStateUpgrades)),
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Workspace identifier.",
@@ -105,11 +122,11 @@ func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								stringvalidator.OneOf("cost", "tokens"),
 							},
 						},
-						"credit_limit": schema.Int64Attribute{
+						"credit_limit": schema.Float64Attribute{
 							Description: "The credit limit value.",
 							Optional:    true,
 						},
-						"alert_threshold": schema.Int64Attribute{
+						"alert_threshold": schema.Float64Attribute{
 							Description: "Alert threshold in dollars. Triggers email notification when usage reaches this amount.",
 							Optional:    true,
 						},
@@ -166,6 +183,28 @@ func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Timestamp when the workspace was last updated.",
 				Computed:    true,
 			},
+			"deletion_policy": schema.StringAttribute{
+				Description: "What destroying this resource does. `cascade` (default) deletes the workspace together with its " +
+					"providers, configs and workspace API keys. `fail_if_not_empty` refuses to delete a workspace that still has " +
+					"providers, configs, prompts or API keys, and lists them. `abandon` removes the workspace from Terraform state " +
+					"and leaves it in Portkey.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(workspaceDeletionCascade),
+				Validators: []validator.String{
+					stringvalidator.OneOf(workspaceDeletionCascade, workspaceDeletionFailIfNotEmpty, workspaceDeletionAbandon),
+				},
+			},
+			"deletion_protection": deletionProtectionAttribute(),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *workspaceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityAttribute("Workspace identifier (UUID)."),
 		},
 	}
 }
@@ -190,6 +229,12 @@ func (r *workspaceResource) Configure(_ context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+// ModifyPlan applies the provider-level deletion_protection default and warns
+// before a protected workspace is destroyed.
+func (r *workspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDeletionProtectionPlan(ctx, req, resp, r.client, "Workspace")
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *workspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -305,6 +350,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -412,12 +458,22 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.CreatedAt = types.StringValue(workspace.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
 	state.UpdatedAt = types.StringValue(workspace.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
 
+	// deletion_policy is provider-side only; fill in the default after import.
+	if state.DeletionPolicy.IsNull() {
+		state.DeletionPolicy = types.StringValue(workspaceDeletionCascade)
+	}
+	// deletion_protection is provider-side only; fill in the default after import.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(defaultDeletionProtection(r.client))
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -439,6 +495,27 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// deletion_policy and deletion_protection are not sent to the API, so a
+	// change to only those keeps the rest of state.
+	if !planChangesAttributes(req.Plan, req.State, "name", "icon", "description", "usage_limits", "rate_limits", "metadata") {
+		var state workspaceResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.DeletionPolicy = plan.DeletionPolicy
+		state.DeletionProtection = plan.DeletionProtection
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
+		return
+	}
+
 	// Update existing workspace
 	updateReq := client.UpdateWorkspaceRequest{
 		Name:        plan.Name.ValueString(),
@@ -569,6 +646,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setIdentityFromState(ctx, r.client, resp.State, resp.Identity, &resp.Diagnostics)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -581,9 +659,40 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if !checkDeletionProtection(state.DeletionProtection, "Workspace", state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	policy := state.DeletionPolicy.ValueString()
+	switch policy {
+	case workspaceDeletionAbandon:
+		// Only remove the workspace from state.
+		return
+	case workspaceDeletionFailIfNotEmpty:
+		dependents, err := r.listDependents(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Workspace Dependents",
+				"Could not check whether workspace "+state.ID.ValueString()+" is empty: "+err.Error(),
+			)
+			return
+		}
+		if !dependents.empty() {
+			resp.Diagnostics.AddError(
+				"Workspace Is Not Empty",
+				"Workspace "+state.ID.ValueString()+" has deletion_policy = \"fail_if_not_empty\" and still contains:\n\n"+
+					dependents.String()+"\n\nDelete these first, or set deletion_policy = \"cascade\" to delete them with the workspace.",
+			)
+			return
+		}
+	}
+
 	// Delete existing workspace (API requires name in body as confirmation).
 	// State stores the clean name (without icon prefix), which is what the API expects.
-	err := r.client.DeleteWorkspace(ctx, state.ID.ValueString(), state.Name.ValueString())
+	// A null policy (state written before deletion_policy existed) cascades,
+	// as workspaces always did.
+	force := policy != workspaceDeletionFailIfNotEmpty
+	err := r.client.DeleteWorkspace(ctx, state.ID.ValueString(), state.Name.ValueString(), force)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Portkey Workspace",
@@ -600,5 +709,98 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 // behavior in Read (no name stripping). Users who want icon management add
 // the icon attribute to their config after import.
 func (r *workspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// MoveState moves portkey_workspace state from another provider and restapi_object workspaces.
+func (r *workspaceResource) MoveState(_ context.Context) []resource.StateMover {
+	return importStateMovers(r,
+		attributeSource("portkey_workspace", "%s", []string{"id"}),
+		restapiObjectSource("/admin/workspaces", restapiObjectID),
+	)
+}
+
+// workspaceStateUpgrades upgrades workspace state from prior schema versions.
+var workspaceStateUpgrades = [][]stateUpgradeStep{
+	// 0 → 1: usage_limits credit_limit and alert_threshold became Float64.
+	// The numbers carry over as written.
+	nil,
+}
+
+// UpgradeState upgrades workspace state written with prior schema versions.
+func (r *workspaceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(workspaceStateUpgrades)
+}
+
+// workspaceDependents lists resources that keep a workspace from being empty,
+// by display name.
+type workspaceDependents struct {
+	Providers []string
+	Configs   []string
+	Prompts   []string
+	APIKeys   []string
+}
+
+// empty reports whether no dependents were found.
+func (d workspaceDependents) empty() bool {
+	return len(d.Providers)+len(d.Configs)+len(d.Prompts)+len(d.APIKeys) == 0
+}
+
+// String renders the dependents as one line per kind.
+func (d workspaceDependents) String() string {
+	var lines []string
+	for _, kind := range []struct {
+		label string
+		names []string
+	}{
+		{"Providers", d.Providers},
+		{"Configs", d.Configs},
+		{"Prompts", d.Prompts},
+		{"API keys", d.APIKeys},
+	} {
+		if len(kind.names) > 0 {
+			lines = append(lines, fmt.Sprintf("- %s (%d): %s", kind.label, len(kind.names), strings.Join(kind.names, ", ")))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// listDependents collects the providers, configs, prompts and API keys of a
+// workspace through their list endpoints.
+func (r *workspaceResource) listDependents(ctx context.Context, workspaceID string) (workspaceDependents, error) {
+	var d workspaceDependents
+
+	providers, err := r.client.ListProviders(ctx, workspaceID)
+	if err != nil {
+		return d, fmt.Errorf("listing providers: %w", err)
+	}
+	for _, p := range providers {
+		d.Providers = append(d.Providers, p.Slug)
+	}
+
+	configs, err := r.client.ListConfigs(ctx, workspaceID)
+	if err != nil {
+		return d, fmt.Errorf("listing configs: %w", err)
+	}
+	for _, c := range configs {
+		d.Configs = append(d.Configs, c.Slug)
+	}
+
+	prompts, err := r.client.ListPrompts(ctx, workspaceID, "")
+	if err != nil {
+		return d, fmt.Errorf("listing prompts: %w", err)
+	}
+	for _, p := range prompts {
+		d.Prompts = append(d.Prompts, p.Slug)
+	}
+
+	keys, err := r.client.ListAPIKeys(ctx, workspaceID)
+	if err != nil {
+		return d, fmt.Errorf("listing API keys: %w", err)
+	}
+	for _, k := range keys {
+		d.APIKeys = append(d.APIKeys, k.Name)
+	}
+
+	return d, nil
 }
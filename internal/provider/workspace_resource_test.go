@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -681,3 +682,67 @@ resource "portkey_workspace" "test" {
 }
 `, name, icon)
 }
+
+func TestWorkspaceDependents_String(t *testing.T) {
+	d := workspaceDependents{
+		Providers: []string{"openai-prod", "anthropic-prod"},
+		APIKeys:   []string{"backend"},
+	}
+	if d.empty() {
+		t.Fatal("expected dependents to be non-empty")
+	}
+
+	want := "- Providers (2): openai-prod, anthropic-prod\n- API keys (1): backend"
+	if got := d.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	if !(workspaceDependents{}).empty() {
+		t.Error("expected zero value to be empty")
+	}
+}
+
+// TestAccWorkspaceResource_deletionProtection verifies that a protected
+// workspace cannot be destroyed, and that an empty workspace is deleted under
+// the fail_if_not_empty policy once protection is lifted.
+func TestAccWorkspaceResource_deletionProtection(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-protected")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceResourceConfigDeletion(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_workspace.test", "deletion_policy", "fail_if_not_empty"),
+					resource.TestCheckResourceAttr("portkey_workspace.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccWorkspaceResourceConfigDeletion(rName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Workspace Is Protected"),
+			},
+			{
+				Config: testAccWorkspaceResourceConfigDeletion(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("portkey_workspace.test", "deletion_protection", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWorkspaceResourceConfigDeletion(name string, protected bool) string {
+	return fmt.Sprintf(`
+provider "portkey" {}
+
+resource "portkey_workspace" "test" {
+  name                = %[1]q
+  deletion_policy     = "fail_if_not_empty"
+  deletion_protection = %[2]t
+}
+`, name, protected)
+}
@@ -28,7 +28,9 @@ type workspacesDataSource struct {
 
 // workspacesDataSourceModel maps the data source schema data.
 type workspacesDataSourceModel struct {
-	Workspaces []workspaceModel `tfsdk:"workspaces"`
+	Workspaces []workspaceModel  `tfsdk:"workspaces"`
+	Filter     []listFilterModel `tfsdk:"filter"`
+	IDs        types.List        `tfsdk:"ids"`
 }
 
 // workspaceModel maps workspace data
@@ -84,11 +86,11 @@ func (d *workspacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 										Description: "Type of usage limit.",
 										Computed:    true,
 									},
-									"credit_limit": schema.Int64Attribute{
+									"credit_limit": schema.Float64Attribute{
 										Description: "The credit limit value.",
 										Computed:    true,
 									},
-									"alert_threshold": schema.Int64Attribute{
+									"alert_threshold": schema.Float64Attribute{
 										Description: "Alert threshold in dollars.",
 										Computed:    true,
 									},
@@ -135,6 +137,10 @@ func (d *workspacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 					},
 				},
 			},
+			"ids": listIDsAttribute("workspaces"),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": listFilterBlock("workspaces"),
 		},
 	}
 }
@@ -161,6 +167,11 @@ func (d *workspacesDataSource) Configure(_ context.Context, req datasource.Confi
 func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state workspacesDataSourceModel
 
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get workspaces from Portkey API
 	workspaces, err := d.client.ListWorkspaces(ctx)
 	if err != nil {
@@ -222,6 +233,12 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		state.Workspaces = append(state.Workspaces, workspaceState)
 	}
 
+	state.Workspaces = applyListFilters(state.Workspaces, state.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IDs = listItemStrings(state.Workspaces, "id")
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/portkey-ai/terraform-provider-portkey/internal/export"
 	"github.com/portkey-ai/terraform-provider-portkey/internal/provider"
 )
 
@@ -26,6 +28,12 @@ var (
 )
 
 func main() {
+	// "export" generates configuration for existing objects instead of
+	// serving the provider.
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(export.Main(context.Background(), os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")